@@ -0,0 +1,3 @@
+data "snyk_group" "platform" {
+  id = "5b0f8c38-8c1b-4b7a-9d1f-2f2b6e1d7c3a"
+}
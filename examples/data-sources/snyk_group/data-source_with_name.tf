@@ -0,0 +1,3 @@
+data "snyk_group" "platform" {
+  name = "Platform"
+}
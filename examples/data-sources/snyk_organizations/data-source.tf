@@ -0,0 +1,14 @@
+data "snyk_organizations" "teams" {
+  group_id    = "5b0f8c38-8c1b-4b7a-9d1f-2f2b6e1d7c3a"
+  name_prefix = "team-"
+}
+
+# Roll out a broker integration to every team organization.
+resource "snyk_broker_integration" "gitlab" {
+  for_each = { for org in data.snyk_organizations.teams.organizations : org.slug => org }
+
+  broker_connection_id = "<broker-connection-id>"
+  organization_id      = each.value.id
+  tenant_id            = each.value.tenant_id
+  type                 = "gitlab"
+}
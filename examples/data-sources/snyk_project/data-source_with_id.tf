@@ -0,0 +1,5 @@
+data "snyk_project" "next-gen-ui" {
+  organization_id = data.snyk_organization.frontend.id
+
+  id = "3b6b3a7c-5b0e-4c3a-8a57-2f8f0e0c6d1e"
+}
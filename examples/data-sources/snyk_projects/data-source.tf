@@ -0,0 +1,11 @@
+data "snyk_projects" "frontend" {
+  organization_id  = "8ad12cbd-994a-4253-816c-ec46a2267f2c"
+  name_regex       = "^frontend/"
+  origin           = "github"
+  status           = "active"
+  target_reference = "main"
+}
+
+output "frontend_project_ids" {
+  value = [for project in data.snyk_projects.frontend.projects : project.id]
+}
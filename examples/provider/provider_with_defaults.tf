@@ -0,0 +1,17 @@
+# Set the variable value in *.tfvars file
+# or using -var="snyk_token=..." CLI option
+variable "snyk_token" {}
+
+# Configure the Snyk Provider with default IDs used by resources
+# and data sources when their own attribute is not set.
+provider "snyk" {
+  default_group_id        = "<group-id>"
+  default_organization_id = "<organization-id>"
+  default_tenant_id       = "<tenant-id>"
+  token                   = var.snyk_token
+}
+
+# The "tenant_id" attribute falls back to the "default_tenant_id" of the provider.
+resource "snyk_broker_deployment" "dev" {
+  app_install_id = "<app-install-id>"
+}
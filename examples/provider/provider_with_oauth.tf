@@ -0,0 +1,13 @@
+# Set the variable values in *.tfvars file
+# or using -var="snyk_client_id=..." CLI option
+variable "snyk_client_id" {}
+variable "snyk_client_secret" {}
+
+# Configure the Snyk Provider to authenticate a service account with
+# OAuth client credentials instead of an API token.
+provider "snyk" {
+  oauth = {
+    client_id     = var.snyk_client_id
+    client_secret = var.snyk_client_secret
+  }
+}
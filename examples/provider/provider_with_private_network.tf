@@ -0,0 +1,19 @@
+# Set the variable value in *.tfvars file
+# or using -var="snyk_token=..." CLI option
+variable "snyk_token" {}
+
+# Configure the Snyk Provider for a private region behind an internal CA
+# and an egress proxy, authenticating the client with mutual TLS.
+provider "snyk" {
+  region = {
+    name          = "my-instance"
+    app_base_url  = "https://app.my-instance.local/"
+    rest_base_url = "https://api.my-instance.local/rest/"
+    v1_base_url   = "https://api.my-instance.local/v1/"
+  }
+  ca_cert_file = "/etc/ssl/certs/internal-ca.pem"
+  client_cert  = file("${path.module}/client.crt")
+  client_key   = file("${path.module}/client.key")
+  proxy_url    = "http://proxy.my-instance.local:3128"
+  token        = var.snyk_token
+}
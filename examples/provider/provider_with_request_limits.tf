@@ -0,0 +1,11 @@
+# Set the variable value in *.tfvars file
+# or using -var="snyk_token=..." CLI option
+variable "snyk_token" {}
+
+# Configure the Snyk Provider to send at most 5 requests per second
+# with no more than 2 requests in flight at a time.
+provider "snyk" {
+  max_concurrent_requests = 2
+  requests_per_second     = 5
+  token                   = var.snyk_token
+}
@@ -0,0 +1,12 @@
+# Set the variable value in *.tfvars file
+# or using -var="snyk_token=..." CLI option
+variable "snyk_token" {}
+
+# Configure the Snyk Provider to retry rate limited requests up to 8 times,
+# waiting between 2 seconds and 1 minute.
+provider "snyk" {
+  max_retries    = 8
+  retry_min_wait = "2s"
+  retry_max_wait = "1m"
+  token          = var.snyk_token
+}
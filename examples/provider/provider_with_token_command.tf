@@ -0,0 +1,12 @@
+# Configure the Snyk Provider to read the API token from a file,
+# e.g. written by a secrets agent. The file is read on every run.
+provider "snyk" {
+  token_file = "/var/run/secrets/snyk/token"
+}
+
+# Configure the Snyk Provider to run a local command printing
+# the API token to stdout, e.g. a vault CLI wrapper.
+provider "snyk" {
+  alias         = "vault"
+  token_command = ["vault", "kv", "get", "-field=token", "secret/snyk"]
+}
@@ -0,0 +1,11 @@
+resource "snyk_broker_connection" "artifactory" {
+  app_install_id       = "<app-install-id>"
+  tenant_id            = "<tenant-id>"
+  broker_deployment_id = "<broker-deployment-id>"
+
+  type = "artifactory"
+  name = "dev-artifactory-connection"
+  configuration = {
+    artifactory_url = "<artifactory-hostname>/artifactory"
+  }
+}
@@ -0,0 +1,23 @@
+resource "snyk_broker_connection" "azure_repos" {
+  app_install_id       = "<app-install-id>"
+  tenant_id            = "<tenant-id>"
+  broker_deployment_id = "<broker-deployment-id>"
+
+  type = "azure-repos"
+  name = "dev-azure-repos-connection"
+  configuration = {
+    broker_client_url               = "https://api.snyk.io"
+    azure_repos_hostname            = "dev.azure.com"
+    azure_repos_organization        = "<azure-repos-organization>"
+    azure_repos_token_credential_id = snyk_broker_deployment_credential.azure_repos.id
+  }
+}
+
+resource "snyk_broker_deployment_credential" "azure_repos" {
+  app_install_id       = "<app-install-id>"
+  tenant_id            = "<tenant-id>"
+  broker_deployment_id = "<broker-deplyment-id>"
+
+  environment_variable_name = "AZURE_REPOS_TOKEN_FOR_DEV_CLUSTER"
+  broker_connection_type    = "azure-repos"
+}
@@ -0,0 +1,22 @@
+resource "snyk_broker_connection" "github_enterprise" {
+  app_install_id       = "<app-install-id>"
+  tenant_id            = "<tenant-id>"
+  broker_deployment_id = "<broker-deployment-id>"
+
+  type = "github-enterprise"
+  name = "dev-github-enterprise-connection"
+  configuration = {
+    broker_client_url          = "https://api.snyk.io"
+    github_hostname            = "<github-enterprise-hostname>"
+    github_token_credential_id = snyk_broker_deployment_credential.github_enterprise.id
+  }
+}
+
+resource "snyk_broker_deployment_credential" "github_enterprise" {
+  app_install_id       = "<app-install-id>"
+  tenant_id            = "<tenant-id>"
+  broker_deployment_id = "<broker-deplyment-id>"
+
+  environment_variable_name = "GHE_TOKEN_FOR_DEV_CLUSTER"
+  broker_connection_type    = "github-enterprise"
+}
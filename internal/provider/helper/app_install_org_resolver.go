@@ -0,0 +1,130 @@
+package helper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/pavel-snyk/snyk-sdk-go/v2/snyk"
+	"golang.org/x/sync/errgroup"
+)
+
+// DefaultAppInstallLookupConcurrency is the default number of organizations
+// searched in parallel for app installations.
+const DefaultAppInstallLookupConcurrency = 8
+
+// AppInstallOrgResolver resolves the organization an app installation belongs to.
+//
+// The lookup requires listing the app installations of every accessible organization,
+// so the results are cached by app install ID for the lifetime of the provider and
+// shared across all resources and data sources. It is safe for concurrent use.
+type AppInstallOrgResolver struct {
+	client      *snyk.Client
+	concurrency int
+
+	mu     sync.RWMutex
+	orgIDs map[string]string // app install ID -> organization ID
+
+	scanMu sync.Mutex // serializes scans, so concurrent misses don't scan all orgs twice
+}
+
+func NewAppInstallOrgResolver(client *snyk.Client, concurrency int) *AppInstallOrgResolver {
+	if concurrency < 1 {
+		concurrency = DefaultAppInstallLookupConcurrency
+	}
+	return &AppInstallOrgResolver{
+		client:      client,
+		concurrency: concurrency,
+		orgIDs:      make(map[string]string),
+	}
+}
+
+// Store remembers the organization of an app installation, e.g. after it was created.
+// It is a no-op on a nil resolver.
+func (r *AppInstallOrgResolver) Store(appInstallID, orgID string) {
+	if r == nil || appInstallID == "" || orgID == "" {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.orgIDs[appInstallID] = orgID
+}
+
+// ResolveOrgID returns the ID of the organization the app installation belongs to,
+// or an empty string if no accessible organization has the app installed.
+// It returns an error on a nil resolver, e.g. if the provider is not configured.
+func (r *AppInstallOrgResolver) ResolveOrgID(ctx context.Context, appInstallID string) (string, error) {
+	if r == nil {
+		return "", errors.New("app install organization resolver is not configured")
+	}
+
+	if orgID, ok := r.load(appInstallID); ok {
+		tflog.Debug(ctx, "Found cached org for app install", map[string]any{"app_install_id": appInstallID, "organization_id": orgID})
+		return orgID, nil
+	}
+
+	r.scanMu.Lock()
+	defer r.scanMu.Unlock()
+
+	// another scan might have found the app install in the meantime
+	if orgID, ok := r.load(appInstallID); ok {
+		return orgID, nil
+	}
+
+	if err := r.scan(ctx); err != nil {
+		return "", err
+	}
+
+	orgID, _ := r.load(appInstallID)
+	return orgID, nil
+}
+
+func (r *AppInstallOrgResolver) load(appInstallID string) (string, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	orgID, ok := r.orgIDs[appInstallID]
+	return orgID, ok
+}
+
+// scan lists the app installations of all accessible organizations and caches them.
+func (r *AppInstallOrgResolver) scan(ctx context.Context) error {
+	tflog.Info(ctx, "Searching in all accessible organizations for app installs", map[string]any{"concurrency": r.concurrency})
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(r.concurrency)
+
+	tflog.Trace(ctx, "Getting all accessible organizations")
+	orgs, errf := r.client.Orgs.AllAccessibleOrgs(gctx, nil)
+	for org := range orgs {
+		g.Go(func() error {
+			tflog.Trace(gctx, "Getting app installs for organization", map[string]any{
+				"organization_id": org.ID, "organization_name": org.Attributes.Name,
+			})
+			appInstalls, resp, err := r.client.Apps.ListAppInstallsForOrg(gctx, org.ID, nil)
+			if err != nil {
+				return fmt.Errorf("unable to get app installs for organization (%s): %w", org.ID, err)
+			}
+			tflog.Trace(gctx, "Got app installs for organization", map[string]any{
+				"data":            appInstalls,
+				"organization_id": org.ID,
+				"snyk_request_id": resp.SnykRequestID,
+			})
+
+			for _, ai := range appInstalls {
+				r.Store(ai.ID, org.ID)
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return err
+	}
+	if err := errf(); err != nil {
+		return fmt.Errorf("unable to get organizations: %w", err)
+	}
+
+	return nil
+}
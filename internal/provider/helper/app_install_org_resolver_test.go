@@ -0,0 +1,172 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pavel-snyk/snyk-sdk-go/v2/snyk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeAppInstallsAPI serves orgCount organizations, each having a single app install
+// with ID "install-<n>", and records the number of requests.
+type fakeAppInstallsAPI struct {
+	orgCount int
+
+	orgListCalls        atomic.Int32
+	appInstallListCalls atomic.Int32
+	inFlight            atomic.Int32
+	maxInFlight         atomic.Int32
+	failingOrgID        string
+}
+
+func (f *fakeAppInstallsAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/rest/orgs" {
+		f.orgListCalls.Add(1)
+		orgs := make([]string, 0, f.orgCount)
+		for i := range f.orgCount {
+			orgs = append(orgs, fmt.Sprintf(`{"id": "org-%d", "type": "org", "attributes": {"name": "org %d"}}`, i, i))
+		}
+		_, _ = fmt.Fprintf(w, `{"data": [%s], "links": {}}`, strings.Join(orgs, ","))
+		return
+	}
+
+	orgID, ok := strings.CutSuffix(strings.TrimPrefix(r.URL.Path, "/rest/orgs/"), "/apps/installs")
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	f.appInstallListCalls.Add(1)
+	inFlight := f.inFlight.Add(1)
+	defer f.inFlight.Add(-1)
+	for {
+		maxInFlight := f.maxInFlight.Load()
+		if inFlight <= maxInFlight || f.maxInFlight.CompareAndSwap(maxInFlight, inFlight) {
+			break
+		}
+	}
+	time.Sleep(5 * time.Millisecond)
+
+	if orgID == f.failingOrgID {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	_, _ = fmt.Fprintf(w, `{"data": [{"id": "install-%s", "type": "app_install", "attributes": {}}]}`, strings.TrimPrefix(orgID, "org-"))
+}
+
+func newTestResolver(t *testing.T, api *fakeAppInstallsAPI, concurrency int) *AppInstallOrgResolver {
+	t.Helper()
+
+	server := httptest.NewServer(api)
+	t.Cleanup(server.Close)
+
+	client, err := snyk.NewClient("test-token", snyk.WithRegion(snyk.Region{
+		Alias:       "SNYK-TEST-01",
+		AppBaseURL:  server.URL + "/",
+		RESTBaseURL: server.URL + "/rest/",
+		V1BaseURL:   server.URL + "/v1/",
+	}))
+	require.NoError(t, err)
+
+	return NewAppInstallOrgResolver(client, concurrency)
+}
+
+func TestAppInstallOrgResolver_ResolveOrgID(t *testing.T) {
+	t.Parallel()
+
+	api := &fakeAppInstallsAPI{orgCount: 20}
+	resolver := newTestResolver(t, api, 4)
+
+	orgID, err := resolver.ResolveOrgID(context.Background(), "install-13")
+	require.NoError(t, err)
+	assert.Equal(t, "org-13", orgID)
+
+	// all app installs are cached by the first scan
+	orgID, err = resolver.ResolveOrgID(context.Background(), "install-7")
+	require.NoError(t, err)
+	assert.Equal(t, "org-7", orgID)
+
+	assert.EqualValues(t, 1, api.orgListCalls.Load())
+	assert.EqualValues(t, 20, api.appInstallListCalls.Load())
+	assert.LessOrEqual(t, api.maxInFlight.Load(), int32(4))
+	assert.Greater(t, api.maxInFlight.Load(), int32(1))
+}
+
+func TestAppInstallOrgResolver_ResolveOrgIDConcurrently(t *testing.T) {
+	t.Parallel()
+
+	api := &fakeAppInstallsAPI{orgCount: 10}
+	resolver := newTestResolver(t, api, DefaultAppInstallLookupConcurrency)
+
+	var wg sync.WaitGroup
+	for i := range 10 {
+		wg.Go(func() {
+			orgID, err := resolver.ResolveOrgID(context.Background(), fmt.Sprintf("install-%d", i))
+			assert.NoError(t, err)
+			assert.Equal(t, fmt.Sprintf("org-%d", i), orgID)
+		})
+	}
+	wg.Wait()
+
+	assert.EqualValues(t, 1, api.orgListCalls.Load())
+}
+
+func TestAppInstallOrgResolver_ResolveOrgIDNotFound(t *testing.T) {
+	t.Parallel()
+
+	api := &fakeAppInstallsAPI{orgCount: 3}
+	resolver := newTestResolver(t, api, 2)
+
+	orgID, err := resolver.ResolveOrgID(context.Background(), "install-unknown")
+	require.NoError(t, err)
+	assert.Empty(t, orgID)
+
+	// a miss scans again, because the app install might have been created in the meantime
+	_, err = resolver.ResolveOrgID(context.Background(), "install-unknown")
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, api.orgListCalls.Load())
+}
+
+func TestAppInstallOrgResolver_ResolveOrgIDWithStoredAppInstall(t *testing.T) {
+	t.Parallel()
+
+	api := &fakeAppInstallsAPI{orgCount: 3}
+	resolver := newTestResolver(t, api, 2)
+
+	resolver.Store("install-new", "org-new")
+	orgID, err := resolver.ResolveOrgID(context.Background(), "install-new")
+
+	require.NoError(t, err)
+	assert.Equal(t, "org-new", orgID)
+	assert.EqualValues(t, 0, api.orgListCalls.Load())
+}
+
+func TestAppInstallOrgResolver_ResolveOrgIDWithError(t *testing.T) {
+	t.Parallel()
+
+	api := &fakeAppInstallsAPI{orgCount: 5, failingOrgID: "org-2"}
+	resolver := newTestResolver(t, api, 2)
+
+	_, err := resolver.ResolveOrgID(context.Background(), "install-4")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unable to get app installs for organization (org-2)")
+}
+
+func TestAppInstallOrgResolver_ResolveOrgIDWithNilResolver(t *testing.T) {
+	t.Parallel()
+
+	var resolver *AppInstallOrgResolver
+	_, err := resolver.ResolveOrgID(context.Background(), "install-1")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not configured")
+}
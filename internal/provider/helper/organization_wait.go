@@ -9,11 +9,18 @@ import (
 	"github.com/pavel-snyk/snyk-sdk-go/v2/snyk"
 )
 
+// WaitOrganizationTenantIDPopulated waits until the tenant of a new organization is populated,
+// at most until the deadline of ctx or 10 minutes without a deadline.
 func WaitOrganizationTenantIDPopulated(ctx context.Context, client *snyk.Client, orgID string) error {
+	timeout := 10 * time.Minute
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = time.Until(deadline)
+	}
+
 	stateConf := &retry.StateChangeConf{
 		Pending:    []string{organizationTenantIDNotPopulated},
 		Target:     []string{organizationTenantIDPopulated},
-		Timeout:    10 * time.Minute,
+		Timeout:    timeout,
 		MinTimeout: 5 * time.Second,
 		Delay:      3 * time.Second,
 		Refresh:    statusOrganizationTenantIDPopulatedState(ctx, client, orgID),
@@ -0,0 +1,44 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// PlanProviderDefault plans the provider default for a string attribute that is not configured, so the value
+// appears in the plan. Values already known from state are kept, so changing a provider default doesn't replace
+// existing resources. If the attribute is required and no default is configured, an error is reported, also when
+// the provider is not configured yet.
+func PlanProviderDefault(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse,
+	attributeName, defaultValue, providerAttributeName string, required bool) {
+	// nothing to plan on resource destroy
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var configValue, planValue types.String
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, path.Root(attributeName), &configValue)...)
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root(attributeName), &planValue)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if !configValue.IsNull() || !(planValue.IsNull() || planValue.IsUnknown()) {
+		return
+	}
+
+	if defaultValue != "" {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(attributeName), defaultValue)...)
+		return
+	}
+	if required {
+		response.Diagnostics.AddAttributeError(
+			path.Root(attributeName),
+			"Missing required attribute",
+			fmt.Sprintf("The %q attribute must be set, or the %q attribute must be configured in the provider.", attributeName, providerAttributeName),
+		)
+	}
+}
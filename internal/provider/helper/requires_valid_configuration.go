@@ -3,6 +3,7 @@ package helper
 import (
 	"context"
 	"fmt"
+	"maps"
 	"slices"
 	"strings"
 
@@ -63,13 +64,14 @@ func (av requiresValidConfiguration) ValidateObject(ctx context.Context, request
 	}
 
 	switch connectionType {
-	case "bitbucket-server":
+	case string(snyk.BrokerConnectionTypeBitbucketServer):
 		av.validateBitbucketConfiguration(request.ConfigValue, request.Path, response)
-	case "gitlab":
-		av.validateGitLabConfiguration(request.ConfigValue, request.Path, response)
-	case "jira":
+	case string(snyk.BrokerConnectionTypeJira):
 		av.validateJiraConfiguration(request.ConfigValue, request.Path, response)
+	default:
+		av.validateRequiredAttributes(request.ConfigValue, request.Path, connectionType, response)
 	}
+	av.validateSupportedAttributes(request.ConfigValue, request.Path, connectionType, response)
 }
 
 func (av requiresValidConfiguration) validateBitbucketConfiguration(config types.Object, path path.Path, response *validator.ObjectResponse) {
@@ -118,20 +120,47 @@ Please provide either the PAT or the username/password combination.`,
 	}
 }
 
-func (av requiresValidConfiguration) validateGitLabConfiguration(config types.Object, path path.Path, response *validator.ObjectResponse) {
-	requiredAttributes := []string{"broker_client_url", "gitlab_hostname", "gitlab_token_credential_id"}
-	for _, attributeName := range requiredAttributes {
+// validateRequiredAttributes checks that all attributes from requiredConfigurationAttributes
+// are set for connection types without alternative authentication methods.
+func (av requiresValidConfiguration) validateRequiredAttributes(config types.Object, path path.Path, connectionType string, response *validator.ObjectResponse) {
+	requirement, ok := requiredConfigurationAttributes()[snyk.BrokerConnectionType(connectionType)]
+	if !ok {
+		return
+	}
+
+	for _, attributeName := range requirement.attributes {
 		value, ok := config.Attributes()[attributeName]
 		if !ok || value.IsNull() {
 			response.Diagnostics.AddAttributeError(
 				path,
-				"Missing required attribute for GitLab",
-				fmt.Sprintf(`Attribute "%s" is required when connection type is "gitlab".`, attributeName),
+				fmt.Sprintf("Missing required attribute for %s", requirement.displayName),
+				fmt.Sprintf(`Attribute "%s" is required when connection type is "%s".`, attributeName, connectionType),
 			)
 		}
 	}
 }
 
+// validateSupportedAttributes checks that only attributes used by the connection type are set,
+// because the API omits all other attributes.
+func (av requiresValidConfiguration) validateSupportedAttributes(config types.Object, path path.Path, connectionType string, response *validator.ObjectResponse) {
+	requirement, ok := supportedConfigurationAttributes(snyk.BrokerConnectionType(connectionType))
+	if !ok {
+		return
+	}
+
+	attributes := config.Attributes()
+	for _, attributeName := range slices.Sorted(maps.Keys(attributes)) {
+		if attributes[attributeName].IsNull() || slices.Contains(requirement.attributes, attributeName) {
+			continue
+		}
+		response.Diagnostics.AddAttributeError(
+			path,
+			fmt.Sprintf("Unsupported attribute for %s", requirement.displayName),
+			fmt.Sprintf(`Attribute "%s" is not supported when connection type is "%s".`, attributeName, connectionType),
+		)
+	}
+}
+
 func (av requiresValidConfiguration) validateJiraConfiguration(config types.Object, path path.Path, response *validator.ObjectResponse) {
 	jiraHostname, ok := config.Attributes()["jira_hostname"]
 	if !ok || jiraHostname.IsNull() {
@@ -176,6 +205,71 @@ func RequiresValidConfiguration(connectionType path.Expression) validator.Object
 	return &requiresValidConfiguration{connectionType}
 }
 
+// configurationRequirement describes which "configuration" attributes must be set or are supported for a connection type.
+type configurationRequirement struct {
+	displayName string
+	attributes  []string
+}
+
+// requiredConfigurationAttributes returns required "configuration" attributes for all connection
+// types except "bitbucket-server" and "jira", which support alternative authentication methods.
+func requiredConfigurationAttributes() map[snyk.BrokerConnectionType]configurationRequirement {
+	containerRegistryAttributes := []string{"broker_client_url", "cr_agent_url", "cr_base", "cr_password_credential_id", "cr_username"}
+	gitHubAppAttributes := []string{
+		"broker_client_url", "github_hostname", "github_api_hostname", "github_app_client_id",
+		"github_app_id", "github_app_installation_id", "github_app_private_pem_path",
+	}
+
+	return map[snyk.BrokerConnectionType]configurationRequirement{
+		snyk.BrokerConnectionTypeACR:           {"Azure Container Registry", containerRegistryAttributes},
+		snyk.BrokerConnectionTypeArtifactory:   {"Artifactory", []string{"artifactory_url"}},
+		snyk.BrokerConnectionTypeArtifactoryCR: {"Artifactory Container Registry", containerRegistryAttributes},
+		snyk.BrokerConnectionTypeAzureRepos: {"Azure Repos", []string{
+			"broker_client_url", "azure_repos_hostname", "azure_repos_organization", "azure_repos_token_credential_id",
+		}},
+		snyk.BrokerConnectionTypeDigitaloceanCR: {"DigitalOcean Container Registry", []string{
+			"broker_client_url", "cr_agent_url", "cr_base", "cr_token_credential_id",
+		}},
+		snyk.BrokerConnectionTypeDockerHub: {"Docker Hub", containerRegistryAttributes},
+		snyk.BrokerConnectionTypeECR: {"Elastic Container Registry", []string{
+			"broker_client_url", "cr_agent_url", "cr_base", "cr_external_id", "cr_region", "cr_role_arn",
+		}},
+		snyk.BrokerConnectionTypeGCR:            {"Google Container Registry", containerRegistryAttributes},
+		snyk.BrokerConnectionTypeGitHub:         {"GitHub", []string{"broker_client_url", "github_token_credential_id"}},
+		snyk.BrokerConnectionTypeGitHubCloudApp: {"GitHub Cloud App", gitHubAppAttributes},
+		snyk.BrokerConnectionTypeGitHubCR:       {"GitHub Container Registry", containerRegistryAttributes},
+		snyk.BrokerConnectionTypeGitHubEnterprise: {"GitHub Enterprise", []string{
+			"broker_client_url", "github_hostname", "github_token_credential_id",
+		}},
+		snyk.BrokerConnectionTypeGitHubServerApp:  {"GitHub Server App", gitHubAppAttributes},
+		snyk.BrokerConnectionTypeGitLab:           {"GitLab", []string{"broker_client_url", "gitlab_hostname", "gitlab_token_credential_id"}},
+		snyk.BrokerConnectionTypeGitLabCR:         {"GitLab Container Registry", containerRegistryAttributes},
+		snyk.BrokerConnectionTypeGoogleArtifactCR: {"Google Artifact Registry", containerRegistryAttributes},
+		snyk.BrokerConnectionTypeHarborCR:         {"Harbor Container Registry", containerRegistryAttributes},
+		snyk.BrokerConnectionTypeNexus:            {"Nexus", []string{"nexus_base_url"}},
+		snyk.BrokerConnectionTypeNexusCR:          {"Nexus Container Registry", containerRegistryAttributes},
+		snyk.BrokerConnectionTypeQuayCR:           {"Quay Container Registry", containerRegistryAttributes},
+	}
+}
+
+// supportedConfigurationAttributes returns all "configuration" attributes used by the connection type.
+func supportedConfigurationAttributes(connectionType snyk.BrokerConnectionType) (configurationRequirement, bool) {
+	switch connectionType {
+	case snyk.BrokerConnectionTypeBitbucketServer:
+		return configurationRequirement{"Bitbucket", []string{
+			"bitbucket_hostname", "bitbucket_password_credential_id", "bitbucket_pat_credential_id", "bitbucket_username", "broker_client_url",
+		}}, true
+	case snyk.BrokerConnectionTypeJira:
+		return configurationRequirement{"Jira", []string{
+			"jira_hostname", "jira_password_credential_id", "jira_pat_credential_id", "jira_username",
+		}}, true
+	}
+
+	// all attributes of the remaining connection types are required
+	requirement, ok := requiredConfigurationAttributes()[connectionType]
+	return requirement, ok
+}
+
 func allowedConnectionTypes() []string {
 	return []string{
 		string(snyk.BrokerConnectionTypeACR),
@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/pavel-snyk/snyk-sdk-go/v2/snyk"
+)
+
+// listAllPages collects the items of all pages returned by fetch, following the next page links
+// until the API stops returning a new starting_after cursor.
+func listAllPages[T any](ctx context.Context, fetch func(ctx context.Context, opts snyk.ListOptions) ([]T, *snyk.Response, error)) ([]T, error) {
+	var items []T
+
+	opts := snyk.ListOptions{Limit: 100}
+	for {
+		page, resp, err := fetch(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, page...)
+
+		if resp.Links == nil || resp.Links.Next == "" {
+			return items, nil
+		}
+		next, err := url.Parse(resp.Links.Next)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse next page link: %w", err)
+		}
+		startingAfter := next.Query().Get("starting_after")
+		if startingAfter == "" || startingAfter == opts.StartingAfter {
+			return items, nil
+		}
+		opts.StartingAfter = startingAfter
+	}
+}
@@ -2,9 +2,19 @@ package provider
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"net/http"
+	"net/url"
 	"os"
+	"os/exec"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -15,10 +25,24 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/pavel-snyk/snyk-sdk-go/v2/snyk"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+
+	"github.com/pavel-snyk/terraform-provider-snyk/internal/provider/helper"
+	"github.com/pavel-snyk/terraform-provider-snyk/internal/transport"
 )
 
 const (
 	defaultSnykRegion = "SNYK-US-01"
+
+	// default timeouts of resource operations, if not set in the timeouts block of a resource.
+	defaultCreateTimeout = 20 * time.Minute
+	defaultReadTimeout   = 5 * time.Minute
+	defaultUpdateTimeout = 20 * time.Minute
+	defaultDeleteTimeout = 20 * time.Minute
+
+	// tokenCommandTimeout is the maximum time of the token_command to print the API token.
+	tokenCommandTimeout = time.Minute
 )
 
 var (
@@ -78,18 +102,168 @@ func (p *snykProvider) Schema(_ context.Context, _ provider.SchemaRequest, respo
 				},
 				Optional: true,
 			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "The path to a PEM-encoded CA bundle used to verify the Snyk API server certificates, e.g. of a private region " +
+					"behind an internal CA. The certificates are added to the system trust store. It can also be sourced from the " +
+					"`SNYK_CA_CERT_FILE` environment variable. Conflicts with `ca_cert_pem`.",
+				Optional: true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "The PEM-encoded CA bundle used to verify the Snyk API server certificates. The certificates are added to " +
+					"the system trust store. It can also be sourced from the `SNYK_CA_CERT_PEM` environment variable. Conflicts with `ca_cert_file`.",
+				Optional: true,
+			},
+			"client_cert": schema.StringAttribute{
+				MarkdownDescription: "The PEM-encoded client certificate for mutual TLS authentication. Must be provided along with `client_key`. " +
+					"It can also be sourced from the `SNYK_CLIENT_CERT` environment variable.",
+				Optional: true,
+			},
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "The PEM-encoded private key of the client certificate for mutual TLS authentication. Must be provided " +
+					"along with `client_cert`. It can also be sourced from the `SNYK_CLIENT_KEY` environment variable.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"default_group_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the group used by resources and data sources when their `group_id` attribute is not set. " +
+					"It can also be sourced from the `SNYK_DEFAULT_GROUP_ID` environment variable.",
+				Optional: true,
+			},
+			"default_organization_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the organization used by resources and data sources when their `organization_id` attribute is not set. " +
+					"It can also be sourced from the `SNYK_DEFAULT_ORGANIZATION_ID` environment variable.",
+				Optional: true,
+			},
+			"default_tenant_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the tenant used by resources when their `tenant_id` attribute is not set. " +
+					"It can also be sourced from the `SNYK_DEFAULT_TENANT_ID` environment variable.",
+				Optional: true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Whether to skip the verification of the Snyk API server certificates. **Use only for testing.** " +
+					"It can also be sourced from the `SNYK_INSECURE_SKIP_VERIFY` environment variable. Defaults to **false**.",
+				Optional: true,
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of concurrent Snyk API requests across all resources and data sources. " +
+					"It can also be sourced from the `SNYK_MAX_CONCURRENT_REQUESTS` environment variable. Defaults to **0** (unlimited).",
+				Optional: true,
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The maximum number of retries of a Snyk API request failed with a rate limit (429) "+
+					"or a transient server error. It can also be sourced from the `SNYK_MAX_RETRIES` environment variable. "+
+					"Defaults to **%d**, `0` disables retries.", transport.DefaultMaxRetries),
+				Optional: true,
+			},
+			"oauth": schema.SingleNestedAttribute{
+				MarkdownDescription: "Configuration for the OAuth 2.0 client credentials authentication of a Snyk service account. " +
+					"Access tokens are fetched and refreshed automatically. When configured, the `token`, `token_file` and `token_command` attributes must not be set.",
+				Attributes: map[string]schema.Attribute{
+					"client_id": schema.StringAttribute{
+						MarkdownDescription: "The OAuth client ID of the service account. It can also be sourced from the `SNYK_OAUTH_CLIENT_ID` environment variable.",
+						Optional:            true,
+					},
+					"client_secret": schema.StringAttribute{
+						MarkdownDescription: "The OAuth client secret of the service account. It can also be sourced from the `SNYK_OAUTH_CLIENT_SECRET` environment variable.",
+						Optional:            true,
+						Sensitive:           true,
+					},
+					"token_url": schema.StringAttribute{
+						MarkdownDescription: "The URL of the OAuth token endpoint. It can also be sourced from the `SNYK_OAUTH_TOKEN_URL` environment variable. " +
+							"Defaults to the `/oauth2/token` endpoint on the host of the region's REST API, e.g. `https://api.snyk.io/oauth2/token`.",
+						Optional: true,
+					},
+				},
+				Optional: true,
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "The URL of the proxy for all Snyk API requests, e.g. `http://proxy.local:3128`. It can also be sourced " +
+					"from the `SNYK_PROXY_URL` environment variable. Defaults to the `HTTPS_PROXY`, `HTTP_PROXY` and `NO_PROXY` environment variables.",
+				Optional: true,
+			},
+			"request_timeout": schema.StringAttribute{
+				MarkdownDescription: "The maximum time of a single Snyk API request attempt, e.g. `30s`. Operations retrying requests " +
+					"or waiting for Snyk are bounded by the `timeouts` of the respective resource. It can also be sourced from the " +
+					"`SNYK_REQUEST_TIMEOUT` environment variable. By default or with `0s`, requests have no timeout.",
+				Optional: true,
+			},
+			"requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "The maximum rate of Snyk API requests per second across all resources and data sources, " +
+					"e.g. `20` or `0.5`. Retries are throttled as well. It can also be sourced from the `SNYK_REQUESTS_PER_SECOND` " +
+					"environment variable. Defaults to **0** (unlimited).",
+				Optional: true,
+			},
+			"retry_max_wait": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The maximum time to wait between two retries, e.g. `30s`. A `Retry-After` header "+
+					"returned by the Snyk API takes precedence. It can also be sourced from the `SNYK_RETRY_MAX_WAIT` environment variable. "+
+					"Defaults to **%s**.", transport.DefaultRetryMaxWait),
+				Optional: true,
+			},
+			"retry_min_wait": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("The time to wait before the first retry, e.g. `1s`. The wait time doubles with "+
+					"every further retry. It can also be sourced from the `SNYK_RETRY_MIN_WAIT` environment variable. "+
+					"Defaults to **%s**.", transport.DefaultRetryMinWait),
+				Optional: true,
+			},
+			"skip_credentials_validation": schema.BoolAttribute{
+				MarkdownDescription: "Whether to skip the validation of the credentials against the configured region. When not skipped, " +
+					"the provider requests the authenticated user once during configuration and fails early on wrong credentials or region. " +
+					"It can also be sourced from the `SNYK_SKIP_CREDENTIALS_VALIDATION` environment variable. Defaults to **false**.",
+				Optional: true,
+			},
 			"token": schema.StringAttribute{
-				MarkdownDescription: "This Snyk API token. It can also be sourced from the `SNYK_TOKEN` environment variable.",
-				Optional:            true,
-				Sensitive:           true,
+				MarkdownDescription: "This Snyk API token. It can also be sourced from the `SNYK_TOKEN` environment variable. " +
+					"Conflicts with `token_command` and `token_file`.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"token_command": schema.ListAttribute{
+				MarkdownDescription: "The command printing the Snyk API token to stdout, e.g. `[\"vault\", \"kv\", \"get\", \"-field=token\", \"secret/snyk\"]`. " +
+					"The first element is the executable, the remaining elements are its arguments, no shell is involved. " +
+					"The command is executed on every provider configuration. " +
+					"It can also be sourced from the `SNYK_TOKEN_COMMAND` environment variable, with arguments separated by whitespace. " +
+					"Conflicts with `token` and `token_file`.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"token_file": schema.StringAttribute{
+				MarkdownDescription: "The path to a file containing the Snyk API token. Surrounding whitespace is trimmed. " +
+					"The file is read on every provider configuration, so the token can be rotated without changing the configuration. " +
+					"It can also be sourced from the `SNYK_TOKEN_FILE` environment variable. Conflicts with `token` and `token_command`.",
+				Optional: true,
 			},
 		},
 	}
 }
 
 type snykProviderModel struct {
-	Region types.Object `tfsdk:"region"` // snykProviderRegionModel
-	Token  types.String `tfsdk:"token"`
+	CACertFile                types.String  `tfsdk:"ca_cert_file"`
+	CACertPEM                 types.String  `tfsdk:"ca_cert_pem"`
+	ClientCert                types.String  `tfsdk:"client_cert"`
+	ClientKey                 types.String  `tfsdk:"client_key"`
+	DefaultGroupID            types.String  `tfsdk:"default_group_id"`
+	DefaultOrganizationID     types.String  `tfsdk:"default_organization_id"`
+	DefaultTenantID           types.String  `tfsdk:"default_tenant_id"`
+	InsecureSkipVerify        types.Bool    `tfsdk:"insecure_skip_verify"`
+	MaxConcurrentRequests     types.Int64   `tfsdk:"max_concurrent_requests"`
+	MaxRetries                types.Int64   `tfsdk:"max_retries"`
+	OAuth                     types.Object  `tfsdk:"oauth"` // snykProviderOAuthModel
+	ProxyURL                  types.String  `tfsdk:"proxy_url"`
+	Region                    types.Object  `tfsdk:"region"` // snykProviderRegionModel
+	RequestTimeout            types.String  `tfsdk:"request_timeout"`
+	RequestsPerSecond         types.Float64 `tfsdk:"requests_per_second"`
+	RetryMaxWait              types.String  `tfsdk:"retry_max_wait"`
+	RetryMinWait              types.String  `tfsdk:"retry_min_wait"`
+	SkipCredentialsValidation types.Bool    `tfsdk:"skip_credentials_validation"`
+	Token                     types.String  `tfsdk:"token"`
+	TokenCommand              types.List    `tfsdk:"token_command"`
+	TokenFile                 types.String  `tfsdk:"token_file"`
+}
+
+type snykProviderOAuthModel struct {
+	ClientID     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+	TokenURL     types.String `tfsdk:"token_url"`
 }
 
 type snykProviderRegionModel struct {
@@ -99,6 +273,18 @@ type snykProviderRegionModel struct {
 	V1BaseURL   types.String `tfsdk:"v1_base_url"`
 }
 
+// snykProviderData is shared with all resources and data sources of a configured provider.
+type snykProviderData struct {
+	// appInstallOrgs caches the organizations of app installations for the provider run.
+	appInstallOrgs *helper.AppInstallOrgResolver
+	client         *snyk.Client
+
+	// defaults for group_id, organization_id and tenant_id attributes, empty if not configured.
+	defaultGroupID  string
+	defaultOrgID    string
+	defaultTenantID string
+}
+
 func (p *snykProvider) ValidateConfig(ctx context.Context, request provider.ValidateConfigRequest, response *provider.ValidateConfigResponse) {
 	var config snykProviderModel
 
@@ -132,12 +318,51 @@ func (p *snykProvider) ValidateConfig(ctx context.Context, request provider.Vali
 		}
 	}
 
-	// validate token
-	if (config.Token.IsNull() || config.Token.ValueString() == "") && os.Getenv("SNYK_TOKEN") == "" {
+	// validate request timeout
+	_, diags = p.resolveRequestTimeout(config)
+	response.Diagnostics.Append(diags...)
+
+	// validate retry
+	_, diags = p.resolveRetryConfig(config)
+	response.Diagnostics.Append(diags...)
+
+	// validate limits
+	_, diags = p.resolveLimitConfig(config)
+	response.Diagnostics.Append(diags...)
+
+	// validate tls and proxy
+	_, diags = p.resolveHTTPConfig(config)
+	response.Diagnostics.Append(diags...)
+
+	// validate credentials validation
+	_ = resolveBoolAttribute(config.SkipCredentialsValidation, "skip_credentials_validation", "SNYK_SKIP_CREDENTIALS_VALIDATION", &response.Diagnostics)
+
+	// validate oauth
+	oauthConfig, diags := p.resolveOAuthConfig(ctx, config, request.Config, regionConfig)
+	response.Diagnostics.Append(diags...)
+	if oauthConfig.enabled() {
+		tokenAttributes := map[string]attr.Value{"token": config.Token, "token_command": config.TokenCommand, "token_file": config.TokenFile}
+		for _, attributeName := range []string{"token", "token_command", "token_file"} {
+			if !tokenAttributes[attributeName].IsNull() {
+				response.Diagnostics.AddAttributeError(
+					path.Root(attributeName),
+					"Conflicting provider config",
+					fmt.Sprintf(`The %q attribute cannot be used together with the "oauth" authentication.`, attributeName),
+				)
+			}
+		}
+		return
+	}
+
+	// validate token, the token file is read and the token command is executed only on Configure
+	tokenConfig, diags := p.resolveTokenConfig(ctx, config)
+	response.Diagnostics.Append(diags...)
+	tokenUnknown := config.Token.IsUnknown() || config.TokenCommand.IsUnknown() || config.TokenFile.IsUnknown()
+	if !tokenConfig.enabled() && !tokenUnknown && !diags.HasError() {
 		response.Diagnostics.AddAttributeError(
 			path.Root("token"),
 			"Invalid provider config",
-			`The Snyk API token must be provided via the "token" attribute or the "SNYK_TOKEN" environment variable.`,
+			missingTokenDetail,
 		)
 	}
 }
@@ -176,28 +401,96 @@ func (p *snykProvider) Configure(ctx context.Context, request provider.Configure
 		opts = append(opts, snyk.WithRegionAlias(defaultSnykRegion))
 	}
 
-	// token logic
-	token := os.Getenv("SNYK_TOKEN")
-	if !config.Token.IsNull() && !config.Token.IsUnknown() {
-		token = config.Token.ValueString()
+	// oauth logic
+	oauthConfig, diags := p.resolveOAuthConfig(ctx, config, request.Config, regionConfig)
+	response.Diagnostics.Append(diags...)
+
+	// token logic, the oauth access token replaces the API token
+	var token string
+	if !oauthConfig.enabled() {
+		tokenConfig, diags := p.resolveTokenConfig(ctx, config)
+		response.Diagnostics.Append(diags...)
+		if !tokenConfig.enabled() && !diags.HasError() {
+			response.Diagnostics.AddAttributeError(
+				path.Root("token"),
+				"Invalid provider config",
+				missingTokenDetail,
+			)
+		}
+		if tokenConfig.enabled() && !diags.HasError() {
+			token, diags = tokenConfig.apiToken(ctx)
+			response.Diagnostics.Append(diags...)
+		}
 	}
-	if token == "" {
-		response.Diagnostics.AddAttributeError(
-			path.Root("token"),
-			"Invalid provider config",
-			`The Snyk API token must be provided via the "token" attribute or the "SNYK_TOKEN" environment variable.`,
-		)
+
+	if response.Diagnostics.HasError() {
+		return
 	}
 
+	// retry logic
+	retryConfig, diags := p.resolveRetryConfig(config)
+	response.Diagnostics.Append(diags...)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
+	// limit logic
+	limitConfig, diags := p.resolveLimitConfig(config)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// tls and proxy logic
+	httpConfig, diags := p.resolveHTTPConfig(config)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// request timeout logic
+	requestTimeout, diags := p.resolveRequestTimeout(config)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	timeoutTransport := &transport.TimeoutTransport{Base: httpConfig.httpTransport(), Timeout: requestTimeout}
+
+	// every retry attempt is throttled and has its own timeout
+	var baseTransport http.RoundTripper = transport.NewLimitTransport(
+		timeoutTransport,
+		limitConfig.requestsPerSecond,
+		limitConfig.maxConcurrentRequests,
+	)
+	if oauthConfig.enabled() {
+		// every retry attempt gets a valid access token
+		baseTransport = &oauth2.Transport{Base: baseTransport, Source: oauthConfig.tokenSource(timeoutTransport)}
+	}
+	opts = append(opts, snyk.WithHTTPClient(&http.Client{
+		Transport: &transport.RetryTransport{
+			Base:       baseTransport,
+			MaxRetries: retryConfig.maxRetries,
+			MinWait:    retryConfig.minWait,
+			MaxWait:    retryConfig.maxWait,
+		},
+	}))
+
 	tflog.Info(ctx, "Configuring Snyk SDK client", map[string]any{
-		"region_name":   regionConfig.name,
-		"app_base_url":  regionConfig.appBaseURL,
-		"rest_base_url": regionConfig.restBaseURL,
-		"v1_base_url":   regionConfig.v1BaseURL,
+		"region_name":     regionConfig.name,
+		"app_base_url":    regionConfig.appBaseURL,
+		"rest_base_url":   regionConfig.restBaseURL,
+		"v1_base_url":     regionConfig.v1BaseURL,
+		"proxy_url":       httpConfig.proxyURL.Redacted(),
+		"custom_tls":      httpConfig.tlsConfig != nil,
+		"oauth":           oauthConfig.enabled(),
+		"oauth_token_url": oauthConfig.tokenURL,
+		"request_timeout": requestTimeout.String(),
+		"max_retries":     retryConfig.maxRetries,
+		"retry_min_wait":  retryConfig.minWait.String(),
+		"retry_max_wait":  retryConfig.maxWait.String(),
+
+		"max_concurrent_requests": limitConfig.maxConcurrentRequests,
+		"requests_per_second":     limitConfig.requestsPerSecond,
 	})
 	client, err := snyk.NewClient(token, opts...)
 	if err != nil {
@@ -210,22 +503,49 @@ func (p *snykProvider) Configure(ctx context.Context, request provider.Configure
 		return
 	}
 
-	response.DataSourceData = client
-	response.ResourceData = client
+	// credentials validation logic, skipped while the provider config contains unknown values
+	skipCredentialsValidation := resolveBoolAttribute(config.SkipCredentialsValidation, "skip_credentials_validation", "SNYK_SKIP_CREDENTIALS_VALIDATION", &response.Diagnostics)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if !skipCredentialsValidation && request.Config.Raw.IsFullyKnown() {
+		response.Diagnostics.Append(validateCredentials(ctx, client, regionConfig.snykRegion())...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	appInstallLookupConcurrency := helper.DefaultAppInstallLookupConcurrency
+	if limitConfig.maxConcurrentRequests > 0 {
+		appInstallLookupConcurrency = min(appInstallLookupConcurrency, limitConfig.maxConcurrentRequests)
+	}
+	providerData := &snykProviderData{
+		appInstallOrgs: helper.NewAppInstallOrgResolver(client, appInstallLookupConcurrency),
+		client:         client,
+
+		defaultGroupID:  resolveStringAttribute(config.DefaultGroupID, "SNYK_DEFAULT_GROUP_ID"),
+		defaultOrgID:    resolveStringAttribute(config.DefaultOrganizationID, "SNYK_DEFAULT_ORGANIZATION_ID"),
+		defaultTenantID: resolveStringAttribute(config.DefaultTenantID, "SNYK_DEFAULT_TENANT_ID"),
+	}
+	response.DataSourceData = providerData
+	response.ResourceData = providerData
 }
 
 func (p *snykProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewAppInstallDataSource,
+		NewGroupDataSource,
 		NewOrganizationDataSource,
-		//NewProjectDataSource,
+		NewOrganizationsDataSource,
+		NewProjectDataSource,
+		NewProjectsDataSource,
 		NewUserDataSource,
 	}
 }
 
 func (p *snykProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
-		//NewIntegrationResource,
+		//NewIntegrationResource, //todo: snyk-sdk-go/v2 has no integrations service yet
 		NewAppInstallResource,
 		NewBrokerConnectionResource,
 		NewBrokerDeploymentResource,
@@ -241,6 +561,34 @@ func (p *snykProvider) userAgent() string {
 	return fmt.Sprintf("%s/%s (+%s)", name, p.version, comment)
 }
 
+// validateCredentials requests the authenticated user to ensure the credentials are valid for the region.
+func validateCredentials(ctx context.Context, client *snyk.Client, region snyk.Region) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.Debug(ctx, "Validating Snyk credentials", map[string]any{"region_name": region.Alias})
+	user, resp, err := client.Users.GetSelf(ctx)
+	if err != nil {
+		status := "no response"
+		if resp != nil && resp.Response != nil {
+			status = resp.Status
+		}
+		diags.AddError(
+			"Unable to validate Snyk credentials",
+			fmt.Sprintf("The credentials could not be validated for region %q (REST API %s, V1 API %s), HTTP status: %s.\n\n"+
+				"Please check that the token or OAuth client belongs to this region, or set \"skip_credentials_validation\" to skip this check.\n\n"+
+				"Snyk Client error: %s", region.Alias, region.RESTBaseURL, region.V1BaseURL, status, err),
+		)
+		return diags
+	}
+	tflog.Debug(ctx, "Validated Snyk credentials", map[string]any{
+		"region_name":     region.Alias,
+		"snyk_request_id": resp.SnykRequestID,
+		"user_id":         user.ID,
+	})
+
+	return diags
+}
+
 // resolvedRegionConfig contains resolved "region" block.
 type resolvedRegionConfig struct {
 	name        string
@@ -249,6 +597,24 @@ type resolvedRegionConfig struct {
 	v1BaseURL   string
 }
 
+// snykRegion returns the custom region if any of the URLs is set, otherwise the predefined region by name.
+func (c resolvedRegionConfig) snykRegion() snyk.Region {
+	if c.appBaseURL != "" || c.restBaseURL != "" || c.v1BaseURL != "" {
+		return snyk.Region{Alias: c.name, AppBaseURL: c.appBaseURL, RESTBaseURL: c.restBaseURL, V1BaseURL: c.v1BaseURL}
+	}
+
+	regionName := c.name
+	if regionName == "" {
+		regionName = defaultSnykRegion
+	}
+	for _, region := range snyk.Regions() {
+		if region.Alias == regionName {
+			return region
+		}
+	}
+	return snyk.Region{Alias: regionName}
+}
+
 func (p *snykProvider) resolveRegionConfig(ctx context.Context, config snykProviderModel, tfConfig tfsdk.Config) (resolvedRegionConfig, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	c := resolvedRegionConfig{
@@ -282,3 +648,488 @@ func (p *snykProvider) resolveRegionConfig(ctx context.Context, config snykProvi
 
 	return c, diags
 }
+
+// resolvedOAuthConfig contains resolved oauth attributes.
+type resolvedOAuthConfig struct {
+	clientID     string
+	clientSecret string
+	tokenURL     string
+}
+
+// enabled reports whether the oauth authentication is configured.
+func (c resolvedOAuthConfig) enabled() bool {
+	return c.clientID != "" || c.clientSecret != ""
+}
+
+// tokenSource returns a token source fetching access tokens with the client credentials grant.
+// The tokens are cached and refreshed shortly before they expire.
+func (c resolvedOAuthConfig) tokenSource(base http.RoundTripper) oauth2.TokenSource {
+	clientCredentials := clientcredentials.Config{
+		ClientID:     c.clientID,
+		ClientSecret: c.clientSecret,
+		TokenURL:     c.tokenURL,
+		AuthStyle:    oauth2.AuthStyleInParams,
+	}
+	// the token source outlives the Configure request, so it must not be bound to its context
+	ctx := context.WithValue(context.Background(), oauth2.HTTPClient, &http.Client{Transport: base})
+	return clientCredentials.TokenSource(ctx)
+}
+
+func (p *snykProvider) resolveOAuthConfig(ctx context.Context, config snykProviderModel, tfConfig tfsdk.Config, regionConfig resolvedRegionConfig) (resolvedOAuthConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	c := resolvedOAuthConfig{
+		clientID:     os.Getenv("SNYK_OAUTH_CLIENT_ID"),
+		clientSecret: os.Getenv("SNYK_OAUTH_CLIENT_SECRET"),
+		tokenURL:     os.Getenv("SNYK_OAUTH_TOKEN_URL"),
+	}
+
+	if !config.OAuth.IsNull() && !config.OAuth.IsUnknown() {
+		var oauthConfig snykProviderOAuthModel
+		diags.Append(tfConfig.GetAttribute(ctx, path.Root("oauth"), &oauthConfig)...)
+		if diags.HasError() {
+			return c, diags
+		}
+
+		// override with HCL values if they are explicitly set
+		if !oauthConfig.ClientID.IsNull() {
+			c.clientID = oauthConfig.ClientID.ValueString()
+		}
+		if !oauthConfig.ClientSecret.IsNull() {
+			c.clientSecret = oauthConfig.ClientSecret.ValueString()
+		}
+		if !oauthConfig.TokenURL.IsNull() {
+			c.tokenURL = oauthConfig.TokenURL.ValueString()
+		}
+	}
+
+	if !c.enabled() {
+		return c, diags
+	}
+	if c.clientID == "" || c.clientSecret == "" {
+		diags.AddAttributeError(
+			path.Root("oauth"),
+			"Incomplete OAuth Configuration",
+			`The "client_id" and "client_secret" must both be set (either in HCL or via "SNYK_OAUTH_CLIENT_ID" and "SNYK_OAUTH_CLIENT_SECRET" environment variables).`,
+		)
+	}
+
+	if c.tokenURL == "" {
+		c.tokenURL = oauthTokenURL(regionConfig)
+	}
+	if u, err := url.Parse(c.tokenURL); err != nil || u.Scheme == "" || u.Host == "" {
+		diags.AddAttributeError(
+			path.Root("oauth").AtName("token_url"),
+			"Invalid provider config",
+			fmt.Sprintf(`The OAuth token URL must be an absolute URL, got %q.`, c.tokenURL),
+		)
+	}
+
+	return c, diags
+}
+
+// oauthTokenURL derives the OAuth token endpoint from the REST API base URL of the region.
+func oauthTokenURL(regionConfig resolvedRegionConfig) string {
+	u, err := url.Parse(regionConfig.snykRegion().RESTBaseURL)
+	if err != nil || u.Host == "" {
+		return ""
+	}
+	return (&url.URL{Scheme: u.Scheme, Host: u.Host, Path: "/oauth2/token"}).String()
+}
+
+const missingTokenDetail = `The Snyk API token must be provided via the "token", "token_file" or "token_command" attribute ` +
+	`or the "SNYK_TOKEN", "SNYK_TOKEN_FILE" or "SNYK_TOKEN_COMMAND" environment variable.`
+
+// resolvedTokenConfig contains the resolved source of the API token, at most one of the fields is set.
+type resolvedTokenConfig struct {
+	token   string
+	file    string
+	command []string
+
+	attributeName string // the attribute reported on errors of the token source
+}
+
+// enabled reports whether a source of the API token is configured.
+func (c resolvedTokenConfig) enabled() bool {
+	return c.token != "" || c.file != "" || len(c.command) > 0
+}
+
+// apiToken returns the API token, reading the token file or executing the token command if needed.
+func (c resolvedTokenConfig) apiToken(ctx context.Context) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var token, source string
+
+	switch {
+	case c.file != "":
+		source = fmt.Sprintf("token file %q", c.file)
+		content, err := os.ReadFile(c.file)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root(c.attributeName),
+				"Unable to read Snyk API token",
+				fmt.Sprintf(`Unable to read the %s: %s`, source, err),
+			)
+			return "", diags
+		}
+		token = string(content)
+	case len(c.command) > 0:
+		source = fmt.Sprintf("token command %q", c.command[0])
+		ctx, cancel := context.WithTimeout(ctx, tokenCommandTimeout)
+		defer cancel()
+
+		var stderr strings.Builder
+		cmd := exec.CommandContext(ctx, c.command[0], c.command[1:]...)
+		cmd.Stderr = &stderr
+		output, err := cmd.Output()
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root(c.attributeName),
+				"Unable to read Snyk API token",
+				fmt.Sprintf("The %s failed: %s\n\n%s", source, err, strings.TrimSpace(stderr.String())),
+			)
+			return "", diags
+		}
+		token = string(output)
+	default:
+		return c.token, diags
+	}
+
+	token = strings.TrimSpace(token)
+	if token == "" {
+		diags.AddAttributeError(
+			path.Root(c.attributeName),
+			"Unable to read Snyk API token",
+			fmt.Sprintf(`The %s returned an empty token.`, source),
+		)
+	}
+	return token, diags
+}
+
+// resolveTokenConfig resolves the source of the API token. The attributes take precedence over the environment
+// variables, in order "SNYK_TOKEN", "SNYK_TOKEN_FILE" and "SNYK_TOKEN_COMMAND". Unknown attributes are skipped.
+func (p *snykProvider) resolveTokenConfig(ctx context.Context, config snykProviderModel) (resolvedTokenConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var c resolvedTokenConfig
+
+	configured := 0
+	for _, value := range []attr.Value{config.Token, config.TokenCommand, config.TokenFile} {
+		if !value.IsNull() {
+			configured++
+		}
+	}
+	if configured > 1 {
+		diags.AddAttributeError(
+			path.Root("token"),
+			"Conflicting provider config",
+			`Only one of "token", "token_file" and "token_command" can be set.`,
+		)
+		return c, diags
+	}
+
+	switch {
+	case !config.Token.IsNull() && !config.Token.IsUnknown():
+		c.token, c.attributeName = config.Token.ValueString(), "token"
+	case !config.TokenFile.IsNull() && !config.TokenFile.IsUnknown():
+		c.file, c.attributeName = config.TokenFile.ValueString(), "token_file"
+	case !config.TokenCommand.IsNull() && !config.TokenCommand.IsUnknown():
+		var command []types.String
+		diags.Append(config.TokenCommand.ElementsAs(ctx, &command, false)...)
+		for _, arg := range command {
+			if arg.IsUnknown() {
+				// resolved on the next run, when all arguments are known
+				return resolvedTokenConfig{}, diags
+			}
+			c.command = append(c.command, arg.ValueString())
+		}
+		c.attributeName = "token_command"
+		if len(c.command) == 0 || c.command[0] == "" {
+			diags.AddAttributeError(
+				path.Root("token_command"),
+				"Invalid provider config",
+				`The "token_command" must contain at least the executable.`,
+			)
+		}
+	case os.Getenv("SNYK_TOKEN") != "":
+		c.token, c.attributeName = os.Getenv("SNYK_TOKEN"), "token"
+	case os.Getenv("SNYK_TOKEN_FILE") != "":
+		c.file, c.attributeName = os.Getenv("SNYK_TOKEN_FILE"), "token_file"
+	case strings.TrimSpace(os.Getenv("SNYK_TOKEN_COMMAND")) != "":
+		c.command, c.attributeName = strings.Fields(os.Getenv("SNYK_TOKEN_COMMAND")), "token_command"
+	}
+
+	return c, diags
+}
+
+// resolvedHTTPConfig contains resolved tls and proxy attributes.
+type resolvedHTTPConfig struct {
+	proxyURL  *url.URL    // nil if proxy is sourced from the environment
+	tlsConfig *tls.Config // nil if default tls config is used
+}
+
+// httpTransport returns a copy of the default transport with the tls and proxy configuration applied.
+func (c resolvedHTTPConfig) httpTransport() *http.Transport {
+	httpTransport := &http.Transport{Proxy: http.ProxyFromEnvironment}
+	if defaultTransport, ok := http.DefaultTransport.(*http.Transport); ok {
+		httpTransport = defaultTransport.Clone()
+	}
+	if c.proxyURL != nil {
+		httpTransport.Proxy = http.ProxyURL(c.proxyURL)
+	}
+	if c.tlsConfig != nil {
+		httpTransport.TLSClientConfig = c.tlsConfig
+	}
+	return httpTransport
+}
+
+func (p *snykProvider) resolveHTTPConfig(config snykProviderModel) (resolvedHTTPConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var c resolvedHTTPConfig
+
+	// proxy
+	if proxyURL := resolveStringAttribute(config.ProxyURL, "SNYK_PROXY_URL"); proxyURL != "" {
+		u, err := url.Parse(proxyURL)
+		if err != nil || !slices.Contains([]string{"http", "https", "socks5"}, u.Scheme) || u.Host == "" {
+			diags.AddAttributeError(
+				path.Root("proxy_url"),
+				"Invalid provider config",
+				fmt.Sprintf(`The "proxy_url" must be an absolute URL with "http", "https" or "socks5" scheme, got %q.`, proxyURL),
+			)
+		}
+		c.proxyURL = u
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	customTLS := false
+
+	// server certificate verification
+	caCertFile := resolveStringAttribute(config.CACertFile, "SNYK_CA_CERT_FILE")
+	caCertPEM := resolveStringAttribute(config.CACertPEM, "SNYK_CA_CERT_PEM")
+	if caCertFile != "" && caCertPEM != "" {
+		diags.AddAttributeError(
+			path.Root("ca_cert_file"),
+			"Conflicting provider config",
+			`Only one of "ca_cert_file" and "ca_cert_pem" can be set (either in HCL or via "SNYK_CA_CERT_FILE" and "SNYK_CA_CERT_PEM" environment variables).`,
+		)
+	}
+	caCertAttribute := "ca_cert_pem"
+	if caCertFile != "" {
+		caCertAttribute = "ca_cert_file"
+		content, err := os.ReadFile(caCertFile)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("ca_cert_file"),
+				"Invalid provider config",
+				fmt.Sprintf(`Unable to read the CA bundle %q: %s`, caCertFile, err),
+			)
+		}
+		caCertPEM = string(content)
+	}
+	if caCertPEM != "" && !diags.HasError() {
+		certPool, err := x509.SystemCertPool()
+		if err != nil {
+			certPool = x509.NewCertPool()
+		}
+		if !certPool.AppendCertsFromPEM([]byte(caCertPEM)) {
+			diags.AddAttributeError(
+				path.Root(caCertAttribute),
+				"Invalid provider config",
+				`The CA bundle must contain at least one PEM-encoded certificate.`,
+			)
+		}
+		tlsConfig.RootCAs = certPool
+		customTLS = true
+	}
+
+	tlsConfig.InsecureSkipVerify = resolveBoolAttribute(config.InsecureSkipVerify, "insecure_skip_verify", "SNYK_INSECURE_SKIP_VERIFY", &diags)
+	customTLS = customTLS || tlsConfig.InsecureSkipVerify
+
+	// mutual tls
+	clientCert := resolveStringAttribute(config.ClientCert, "SNYK_CLIENT_CERT")
+	clientKey := resolveStringAttribute(config.ClientKey, "SNYK_CLIENT_KEY")
+	if (clientCert == "") != (clientKey == "") {
+		diags.AddAttributeError(
+			path.Root("client_cert"),
+			"Incomplete provider config",
+			`The "client_cert" and "client_key" must both be set (either in HCL or via "SNYK_CLIENT_CERT" and "SNYK_CLIENT_KEY" environment variables).`,
+		)
+	} else if clientCert != "" {
+		certificate, err := tls.X509KeyPair([]byte(clientCert), []byte(clientKey))
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("client_cert"),
+				"Invalid provider config",
+				fmt.Sprintf(`The "client_cert" and "client_key" must be a valid PEM-encoded certificate and private key: %s`, err),
+			)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+		customTLS = true
+	}
+
+	if customTLS {
+		c.tlsConfig = tlsConfig
+	}
+	return c, diags
+}
+
+// resolveBoolAttribute returns the attribute value if set, otherwise the value of the environment variable or false.
+func resolveBoolAttribute(value types.Bool, attributeName, envName string, diags *diag.Diagnostics) bool {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueBool()
+	}
+	v := os.Getenv(envName)
+	if v == "" {
+		return false
+	}
+
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root(attributeName),
+			"Invalid provider config",
+			fmt.Sprintf(`The %q environment variable must be a boolean, got %q.`, envName, v),
+		)
+	}
+	return b
+}
+
+// resolveStringAttribute returns the attribute value if set, otherwise the value of the environment variable.
+func resolveStringAttribute(value types.String, envName string) string {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueString()
+	}
+	return os.Getenv(envName)
+}
+
+func (p *snykProvider) resolveRequestTimeout(config snykProviderModel) (time.Duration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	requestTimeout := resolveDurationAttribute(config.RequestTimeout, "request_timeout", "SNYK_REQUEST_TIMEOUT", 0, &diags)
+	return requestTimeout, diags
+}
+
+// resolvedRetryConfig contains resolved retry attributes.
+type resolvedRetryConfig struct {
+	maxRetries int
+	minWait    time.Duration
+	maxWait    time.Duration
+}
+
+func (p *snykProvider) resolveRetryConfig(config snykProviderModel) (resolvedRetryConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	c := resolvedRetryConfig{
+		maxRetries: transport.DefaultMaxRetries,
+		minWait:    transport.DefaultRetryMinWait,
+		maxWait:    transport.DefaultRetryMaxWait,
+	}
+
+	if v := os.Getenv("SNYK_MAX_RETRIES"); v != "" {
+		maxRetries, err := strconv.Atoi(v)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("max_retries"),
+				"Invalid provider config",
+				fmt.Sprintf(`The "SNYK_MAX_RETRIES" environment variable must be a number, got %q.`, v),
+			)
+		}
+		c.maxRetries = maxRetries
+	}
+	if !config.MaxRetries.IsNull() && !config.MaxRetries.IsUnknown() {
+		c.maxRetries = int(config.MaxRetries.ValueInt64())
+	}
+	if c.maxRetries < 0 {
+		diags.AddAttributeError(
+			path.Root("max_retries"),
+			"Invalid provider config",
+			fmt.Sprintf(`The "max_retries" attribute must not be negative, got %d.`, c.maxRetries),
+		)
+	}
+
+	c.minWait = resolveDurationAttribute(config.RetryMinWait, "retry_min_wait", "SNYK_RETRY_MIN_WAIT", c.minWait, &diags)
+	c.maxWait = resolveDurationAttribute(config.RetryMaxWait, "retry_max_wait", "SNYK_RETRY_MAX_WAIT", c.maxWait, &diags)
+	if !diags.HasError() && c.minWait > c.maxWait {
+		diags.AddAttributeError(
+			path.Root("retry_min_wait"),
+			"Invalid provider config",
+			fmt.Sprintf(`The "retry_min_wait" (%s) must not be greater than "retry_max_wait" (%s).`, c.minWait, c.maxWait),
+		)
+	}
+
+	return c, diags
+}
+
+// resolveDurationAttribute returns the duration configured in HCL, in the environment variable or the default value.
+func resolveDurationAttribute(value types.String, attributeName, envName string, defaultValue time.Duration, diags *diag.Diagnostics) time.Duration {
+	raw, source := os.Getenv(envName), fmt.Sprintf("%q environment variable", envName)
+	if !value.IsNull() && !value.IsUnknown() {
+		raw, source = value.ValueString(), fmt.Sprintf("%q attribute", attributeName)
+	}
+	if raw == "" {
+		return defaultValue
+	}
+
+	duration, err := time.ParseDuration(raw)
+	if err != nil || duration < 0 {
+		diags.AddAttributeError(
+			path.Root(attributeName),
+			"Invalid provider config",
+			fmt.Sprintf(`The %s must be a non-negative duration, e.g. "1s" or "2m", got %q.`, source, raw),
+		)
+		return defaultValue
+	}
+	return duration
+}
+
+// resolvedLimitConfig contains resolved request limit attributes.
+type resolvedLimitConfig struct {
+	maxConcurrentRequests int
+	requestsPerSecond     float64
+}
+
+func (p *snykProvider) resolveLimitConfig(config snykProviderModel) (resolvedLimitConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var c resolvedLimitConfig
+
+	if v := os.Getenv("SNYK_MAX_CONCURRENT_REQUESTS"); v != "" {
+		maxConcurrentRequests, err := strconv.Atoi(v)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("max_concurrent_requests"),
+				"Invalid provider config",
+				fmt.Sprintf(`The "SNYK_MAX_CONCURRENT_REQUESTS" environment variable must be a number, got %q.`, v),
+			)
+		}
+		c.maxConcurrentRequests = maxConcurrentRequests
+	}
+	if !config.MaxConcurrentRequests.IsNull() && !config.MaxConcurrentRequests.IsUnknown() {
+		c.maxConcurrentRequests = int(config.MaxConcurrentRequests.ValueInt64())
+	}
+	if c.maxConcurrentRequests < 0 {
+		diags.AddAttributeError(
+			path.Root("max_concurrent_requests"),
+			"Invalid provider config",
+			fmt.Sprintf(`The "max_concurrent_requests" attribute must not be negative, got %d.`, c.maxConcurrentRequests),
+		)
+	}
+
+	if v := os.Getenv("SNYK_REQUESTS_PER_SECOND"); v != "" {
+		requestsPerSecond, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("requests_per_second"),
+				"Invalid provider config",
+				fmt.Sprintf(`The "SNYK_REQUESTS_PER_SECOND" environment variable must be a number, got %q.`, v),
+			)
+		}
+		c.requestsPerSecond = requestsPerSecond
+	}
+	if !config.RequestsPerSecond.IsNull() && !config.RequestsPerSecond.IsUnknown() {
+		c.requestsPerSecond = config.RequestsPerSecond.ValueFloat64()
+	}
+	if c.requestsPerSecond < 0 {
+		diags.AddAttributeError(
+			path.Root("requests_per_second"),
+			"Invalid provider config",
+			fmt.Sprintf(`The "requests_per_second" attribute must not be negative, got %v.`, c.requestsPerSecond),
+		)
+	}
+
+	return c, diags
+}
@@ -1,14 +1,43 @@
 package provider
 
 import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"regexp"
+	"slices"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	fwdatasource "github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/pavel-snyk/snyk-sdk-go/v2/snyk"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 const accTestPrefix = "tf-acc-test"
@@ -42,6 +71,87 @@ func accTestGroupID() string {
 	return os.Getenv("SNYK_GROUP_ID")
 }
 
+// testAccImportStateIDFunc builds a composite import identifier by joining the given
+// attributes of resourceName from state with commas.
+func testAccImportStateIDFunc(resourceName string, attributes ...string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+
+		idParts := make([]string, 0, len(attributes))
+		for _, attribute := range attributes {
+			idParts = append(idParts, rs.Primary.Attributes[attribute])
+		}
+
+		return strings.Join(idParts, ","), nil
+	}
+}
+
+// testImportStateAndRead imports the resource with the given import identifier and refreshes it
+// like Terraform does, it returns the refreshed state.
+func testImportStateAndRead(t *testing.T, r fwresource.ResourceWithImportState, importID string) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResponse := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResponse)
+	require.False(t, schemaResponse.Diagnostics.HasError())
+	emptyState := tfsdk.State{
+		Schema: schemaResponse.Schema,
+		Raw:    tftypes.NewValue(schemaResponse.Schema.Type().TerraformType(ctx), nil),
+	}
+
+	importResponse := &fwresource.ImportStateResponse{State: emptyState}
+	r.ImportState(ctx, fwresource.ImportStateRequest{ID: importID}, importResponse)
+	require.False(t, importResponse.Diagnostics.HasError(), "unexpected diagnostics: %v", importResponse.Diagnostics)
+
+	readResponse := &fwresource.ReadResponse{State: importResponse.State}
+	r.Read(ctx, fwresource.ReadRequest{State: importResponse.State}, readResponse)
+	require.False(t, readResponse.Diagnostics.HasError(), "unexpected diagnostics: %v", readResponse.Diagnostics)
+
+	return readResponse.State
+}
+
+// testImportStateWithMalformedID checks that the resource rejects every given import identifier
+// with an error describing the expected format.
+func testImportStateWithMalformedID(t *testing.T, r fwresource.ResourceWithImportState, expectedFormat string, importIDs map[string]string) {
+	t.Helper()
+
+	for name, importID := range importIDs {
+		t.Run(name, func(t *testing.T) {
+			response := &fwresource.ImportStateResponse{}
+			r.ImportState(context.Background(), fwresource.ImportStateRequest{ID: importID}, response)
+
+			assert.True(t, response.Diagnostics.HasError())
+			assert.Contains(t, response.Diagnostics[0].Detail(), fmt.Sprintf("Expected import identifier with format: %s.", expectedFormat))
+		})
+	}
+}
+
+// newTestClient starts a fake Snyk API server serving handler and returns a client
+// configured to send all REST, V1 and App requests to it.
+func newTestClient(t *testing.T, handler http.Handler) *snyk.Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client, err := snyk.NewClient("test-token",
+		snyk.WithUserAgent("terraform-provider-snyk/test"),
+		snyk.WithRegion(snyk.Region{
+			Alias:       "SNYK-TEST-01",
+			AppBaseURL:  server.URL + "/",
+			RESTBaseURL: server.URL + "/rest/",
+			V1BaseURL:   server.URL + "/v1/",
+		}),
+	)
+	require.NoError(t, err)
+
+	return client
+}
+
 func TestProvider_MissingTokenAttribute(t *testing.T) {
 	t.Parallel()
 
@@ -88,3 +198,920 @@ func TestProvider_UserAgent(t *testing.T) {
 		})
 	}
 }
+
+func TestProvider_resolveRetryConfig(t *testing.T) {
+	tests := map[string]struct {
+		config            snykProviderModel
+		env               map[string]string
+		expected          resolvedRetryConfig
+		expectedErrorText string
+	}{
+		"defaults": {
+			config:   testProviderModel(),
+			expected: resolvedRetryConfig{maxRetries: 4, minWait: time.Second, maxWait: 30 * time.Second},
+		},
+		"from-env": {
+			config:   testProviderModel(),
+			env:      map[string]string{"SNYK_MAX_RETRIES": "2", "SNYK_RETRY_MIN_WAIT": "500ms", "SNYK_RETRY_MAX_WAIT": "1m"},
+			expected: resolvedRetryConfig{maxRetries: 2, minWait: 500 * time.Millisecond, maxWait: time.Minute},
+		},
+		"hcl-overrides-env": {
+			config: func() snykProviderModel {
+				c := testProviderModel()
+				c.MaxRetries = types.Int64Value(0)
+				c.RetryMinWait = types.StringValue("2s")
+				c.RetryMaxWait = types.StringValue("10s")
+				return c
+			}(),
+			env:      map[string]string{"SNYK_MAX_RETRIES": "2", "SNYK_RETRY_MIN_WAIT": "500ms", "SNYK_RETRY_MAX_WAIT": "1m"},
+			expected: resolvedRetryConfig{maxRetries: 0, minWait: 2 * time.Second, maxWait: 10 * time.Second},
+		},
+		"invalid-env-max-retries": {
+			config:            testProviderModel(),
+			env:               map[string]string{"SNYK_MAX_RETRIES": "many"},
+			expectedErrorText: `The "SNYK_MAX_RETRIES" environment variable must be a number, got "many".`,
+		},
+		"negative-max-retries": {
+			config: func() snykProviderModel {
+				c := testProviderModel()
+				c.MaxRetries = types.Int64Value(-1)
+				return c
+			}(),
+			expectedErrorText: `The "max_retries" attribute must not be negative, got -1.`,
+		},
+		"invalid-duration": {
+			config: func() snykProviderModel {
+				c := testProviderModel()
+				c.RetryMinWait = types.StringValue("1 second")
+				return c
+			}(),
+			expectedErrorText: `The "retry_min_wait" attribute must be a non-negative duration, e.g. "1s" or "2m", got "1 second".`,
+		},
+		"min-wait-greater-than-max-wait": {
+			config:            testProviderModel(),
+			env:               map[string]string{"SNYK_RETRY_MIN_WAIT": "1m", "SNYK_RETRY_MAX_WAIT": "1s"},
+			expectedErrorText: `The "retry_min_wait" (1m0s) must not be greater than "retry_max_wait" (1s).`,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			for _, env := range []string{"SNYK_MAX_RETRIES", "SNYK_RETRY_MIN_WAIT", "SNYK_RETRY_MAX_WAIT"} {
+				t.Setenv(env, test.env[env])
+			}
+
+			actual, diags := (&snykProvider{}).resolveRetryConfig(test.config)
+
+			if test.expectedErrorText != "" {
+				require.True(t, diags.HasError())
+				assert.Equal(t, test.expectedErrorText, diags[0].Detail())
+				return
+			}
+			require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestProvider_resolveRequestTimeout(t *testing.T) {
+	tests := map[string]struct {
+		config            snykProviderModel
+		env               string
+		expected          time.Duration
+		expectedErrorText string
+	}{
+		"default": {
+			config:   testProviderModel(),
+			expected: 0,
+		},
+		"from-env": {
+			config:   testProviderModel(),
+			env:      "1m",
+			expected: time.Minute,
+		},
+		"hcl-overrides-env": {
+			config: func() snykProviderModel {
+				c := testProviderModel()
+				c.RequestTimeout = types.StringValue("30s")
+				return c
+			}(),
+			env:      "1m",
+			expected: 30 * time.Second,
+		},
+		"invalid-duration": {
+			config: func() snykProviderModel {
+				c := testProviderModel()
+				c.RequestTimeout = types.StringValue("-5s")
+				return c
+			}(),
+			expectedErrorText: `The "request_timeout" attribute must be a non-negative duration, e.g. "1s" or "2m", got "-5s".`,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("SNYK_REQUEST_TIMEOUT", test.env)
+
+			actual, diags := (&snykProvider{}).resolveRequestTimeout(test.config)
+
+			if test.expectedErrorText != "" {
+				require.True(t, diags.HasError())
+				assert.Equal(t, test.expectedErrorText, diags[0].Detail())
+				return
+			}
+			require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestProvider_resolveLimitConfig(t *testing.T) {
+	tests := map[string]struct {
+		config            snykProviderModel
+		env               map[string]string
+		expected          resolvedLimitConfig
+		expectedErrorText string
+	}{
+		"defaults": {
+			config:   testProviderModel(),
+			expected: resolvedLimitConfig{maxConcurrentRequests: 0, requestsPerSecond: 0},
+		},
+		"from-env": {
+			config:   testProviderModel(),
+			env:      map[string]string{"SNYK_MAX_CONCURRENT_REQUESTS": "4", "SNYK_REQUESTS_PER_SECOND": "2.5"},
+			expected: resolvedLimitConfig{maxConcurrentRequests: 4, requestsPerSecond: 2.5},
+		},
+		"hcl-overrides-env": {
+			config: func() snykProviderModel {
+				c := testProviderModel()
+				c.MaxConcurrentRequests = types.Int64Value(1)
+				c.RequestsPerSecond = types.Float64Value(10)
+				return c
+			}(),
+			env:      map[string]string{"SNYK_MAX_CONCURRENT_REQUESTS": "4", "SNYK_REQUESTS_PER_SECOND": "2.5"},
+			expected: resolvedLimitConfig{maxConcurrentRequests: 1, requestsPerSecond: 10},
+		},
+		"invalid-env-requests-per-second": {
+			config:            testProviderModel(),
+			env:               map[string]string{"SNYK_REQUESTS_PER_SECOND": "fast"},
+			expectedErrorText: `The "SNYK_REQUESTS_PER_SECOND" environment variable must be a number, got "fast".`,
+		},
+		"negative-max-concurrent-requests": {
+			config: func() snykProviderModel {
+				c := testProviderModel()
+				c.MaxConcurrentRequests = types.Int64Value(-1)
+				return c
+			}(),
+			expectedErrorText: `The "max_concurrent_requests" attribute must not be negative, got -1.`,
+		},
+		"negative-requests-per-second": {
+			config: func() snykProviderModel {
+				c := testProviderModel()
+				c.RequestsPerSecond = types.Float64Value(-0.5)
+				return c
+			}(),
+			expectedErrorText: `The "requests_per_second" attribute must not be negative, got -0.5.`,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			for _, env := range []string{"SNYK_MAX_CONCURRENT_REQUESTS", "SNYK_REQUESTS_PER_SECOND"} {
+				t.Setenv(env, test.env[env])
+			}
+
+			actual, diags := (&snykProvider{}).resolveLimitConfig(test.config)
+
+			if test.expectedErrorText != "" {
+				require.True(t, diags.HasError())
+				assert.Equal(t, test.expectedErrorText, diags[0].Detail())
+				return
+			}
+			require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestProvider_ConfigureRetriesRateLimitedRequests(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/self" {
+			http.NotFound(w, r)
+			return
+		}
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{"data": {"id": "user-id", "type": "user", "attributes": {"name": "Test User"}}}`)
+	}))
+	t.Cleanup(server.Close)
+	t.Setenv("SNYK_APP_BASE_URL", server.URL+"/")
+	t.Setenv("SNYK_REST_BASE_URL", server.URL+"/rest/")
+	t.Setenv("SNYK_V1_BASE_URL", server.URL+"/v1/")
+	t.Setenv("SNYK_REGION", "SNYK-TEST-01")
+	t.Setenv("SNYK_TOKEN", "test-token")
+	t.Setenv("SNYK_MAX_RETRIES", "")
+	t.Setenv("SNYK_RETRY_MIN_WAIT", "")
+	t.Setenv("SNYK_RETRY_MAX_WAIT", "")
+
+	providerData := testConfigureProvider(t, map[string]tftypes.Value{
+		"max_retries":    tftypes.NewValue(tftypes.Number, 1),
+		"retry_min_wait": tftypes.NewValue(tftypes.String, "1ms"),
+	})
+	user, _, err := providerData.client.Users.GetSelf(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, "user-id", user.ID)
+	assert.EqualValues(t, 2, calls.Load())
+}
+
+func TestProvider_resolveOAuthConfig(t *testing.T) {
+	tests := map[string]struct {
+		env               map[string]string
+		regionConfig      resolvedRegionConfig
+		expected          resolvedOAuthConfig
+		expectedErrorText string
+	}{
+		"disabled": {
+			expected: resolvedOAuthConfig{},
+		},
+		"default-region-token-url": {
+			env: map[string]string{"SNYK_OAUTH_CLIENT_ID": "client-id", "SNYK_OAUTH_CLIENT_SECRET": "client-secret"},
+			expected: resolvedOAuthConfig{
+				clientID:     "client-id",
+				clientSecret: "client-secret",
+				tokenURL:     "https://api.snyk.io/oauth2/token",
+			},
+		},
+		"predefined-region-token-url": {
+			env:          map[string]string{"SNYK_OAUTH_CLIENT_ID": "client-id", "SNYK_OAUTH_CLIENT_SECRET": "client-secret"},
+			regionConfig: resolvedRegionConfig{name: "SNYK-AU-01"},
+			expected: resolvedOAuthConfig{
+				clientID:     "client-id",
+				clientSecret: "client-secret",
+				tokenURL:     "https://api.au.snyk.io/oauth2/token",
+			},
+		},
+		"custom-region-token-url": {
+			env:          map[string]string{"SNYK_OAUTH_CLIENT_ID": "client-id", "SNYK_OAUTH_CLIENT_SECRET": "client-secret"},
+			regionConfig: resolvedRegionConfig{name: "my-instance", restBaseURL: "https://api.my-instance.local/rest/"},
+			expected: resolvedOAuthConfig{
+				clientID:     "client-id",
+				clientSecret: "client-secret",
+				tokenURL:     "https://api.my-instance.local/oauth2/token",
+			},
+		},
+		"explicit-token-url": {
+			env: map[string]string{
+				"SNYK_OAUTH_CLIENT_ID":     "client-id",
+				"SNYK_OAUTH_CLIENT_SECRET": "client-secret",
+				"SNYK_OAUTH_TOKEN_URL":     "https://auth.example.com/token",
+			},
+			expected: resolvedOAuthConfig{
+				clientID:     "client-id",
+				clientSecret: "client-secret",
+				tokenURL:     "https://auth.example.com/token",
+			},
+		},
+		"missing-client-secret": {
+			env:               map[string]string{"SNYK_OAUTH_CLIENT_ID": "client-id"},
+			expectedErrorText: `The "client_id" and "client_secret" must both be set (either in HCL or via "SNYK_OAUTH_CLIENT_ID" and "SNYK_OAUTH_CLIENT_SECRET" environment variables).`,
+		},
+		"relative-token-url": {
+			env: map[string]string{
+				"SNYK_OAUTH_CLIENT_ID":     "client-id",
+				"SNYK_OAUTH_CLIENT_SECRET": "client-secret",
+				"SNYK_OAUTH_TOKEN_URL":     "/oauth2/token",
+			},
+			expectedErrorText: `The OAuth token URL must be an absolute URL, got "/oauth2/token".`,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			for _, env := range []string{"SNYK_OAUTH_CLIENT_ID", "SNYK_OAUTH_CLIENT_SECRET", "SNYK_OAUTH_TOKEN_URL"} {
+				t.Setenv(env, test.env[env])
+			}
+
+			actual, diags := (&snykProvider{}).resolveOAuthConfig(context.Background(), testProviderModel(), tfsdk.Config{}, test.regionConfig)
+
+			if test.expectedErrorText != "" {
+				require.True(t, diags.HasError())
+				assert.Equal(t, test.expectedErrorText, diags[0].Detail())
+				return
+			}
+			require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestProvider_ConfigureAuthenticatesWithOAuth(t *testing.T) {
+	var tokenRequests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth2/token":
+			if r.FormValue("grant_type") != "client_credentials" ||
+				r.FormValue("client_id") != "client-id" || r.FormValue("client_secret") != "client-secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			// tokens expiring within the refresh window are fetched again on every request
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = fmt.Fprintf(w, `{"access_token": "access-token-%d", "token_type": "bearer", "expires_in": 1}`, tokenRequests.Add(1))
+		case "/rest/self":
+			if r.Header.Get("Authorization") != fmt.Sprintf("Bearer access-token-%d", tokenRequests.Load()) {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = fmt.Fprint(w, `{"data": {"id": "user-id", "type": "user", "attributes": {"name": "Test User"}}}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+	t.Setenv("SNYK_APP_BASE_URL", server.URL+"/")
+	t.Setenv("SNYK_REST_BASE_URL", server.URL+"/rest/")
+	t.Setenv("SNYK_V1_BASE_URL", server.URL+"/v1/")
+	t.Setenv("SNYK_REGION", "SNYK-TEST-01")
+	t.Setenv("SNYK_TOKEN", "")
+	t.Setenv("SNYK_OAUTH_CLIENT_ID", "")
+	t.Setenv("SNYK_OAUTH_CLIENT_SECRET", "")
+	t.Setenv("SNYK_OAUTH_TOKEN_URL", "")
+
+	oauthType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"client_id":     tftypes.String,
+		"client_secret": tftypes.String,
+		"token_url":     tftypes.String,
+	}}
+	providerData := testConfigureProvider(t, map[string]tftypes.Value{
+		"oauth": tftypes.NewValue(oauthType, map[string]tftypes.Value{
+			"client_id":     tftypes.NewValue(tftypes.String, "client-id"),
+			"client_secret": tftypes.NewValue(tftypes.String, "client-secret"),
+			"token_url":     tftypes.NewValue(tftypes.String, nil),
+		}),
+	})
+	for range 2 {
+		user, _, err := providerData.client.Users.GetSelf(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, "user-id", user.ID)
+	}
+	assert.EqualValues(t, 2, tokenRequests.Load())
+}
+
+func TestProvider_resolveTokenConfig(t *testing.T) {
+	tests := map[string]struct {
+		config            func(config *snykProviderModel)
+		env               map[string]string
+		expected          resolvedTokenConfig
+		expectedErrorText string
+	}{
+		"missing": {
+			expected: resolvedTokenConfig{},
+		},
+		"token": {
+			config:   func(config *snykProviderModel) { config.Token = types.StringValue("token") },
+			env:      map[string]string{"SNYK_TOKEN_FILE": "/env/token"},
+			expected: resolvedTokenConfig{token: "token", attributeName: "token"},
+		},
+		"token-file": {
+			config:   func(config *snykProviderModel) { config.TokenFile = types.StringValue("/snyk/token") },
+			env:      map[string]string{"SNYK_TOKEN": "env-token"},
+			expected: resolvedTokenConfig{file: "/snyk/token", attributeName: "token_file"},
+		},
+		"token-command": {
+			config: func(config *snykProviderModel) {
+				config.TokenCommand = types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("vault"),
+					types.StringValue("read"),
+					types.StringValue("-field=token"),
+				})
+			},
+			env:      map[string]string{"SNYK_TOKEN": "env-token"},
+			expected: resolvedTokenConfig{command: []string{"vault", "read", "-field=token"}, attributeName: "token_command"},
+		},
+		"env-token-precedes-env-token-file": {
+			env:      map[string]string{"SNYK_TOKEN": "env-token", "SNYK_TOKEN_FILE": "/env/token"},
+			expected: resolvedTokenConfig{token: "env-token", attributeName: "token"},
+		},
+		"env-token-file-precedes-env-token-command": {
+			env:      map[string]string{"SNYK_TOKEN_FILE": "/env/token", "SNYK_TOKEN_COMMAND": "vault read"},
+			expected: resolvedTokenConfig{file: "/env/token", attributeName: "token_file"},
+		},
+		"env-token-command": {
+			env:      map[string]string{"SNYK_TOKEN_COMMAND": " vault  read -field=token "},
+			expected: resolvedTokenConfig{command: []string{"vault", "read", "-field=token"}, attributeName: "token_command"},
+		},
+		"unknown-token-command": {
+			config:   func(config *snykProviderModel) { config.TokenCommand = types.ListUnknown(types.StringType) },
+			expected: resolvedTokenConfig{},
+		},
+		"conflicting-attributes": {
+			config: func(config *snykProviderModel) {
+				config.Token = types.StringValue("token")
+				config.TokenFile = types.StringValue("/snyk/token")
+			},
+			expectedErrorText: `Only one of "token", "token_file" and "token_command" can be set.`,
+		},
+		"empty-token-command": {
+			config: func(config *snykProviderModel) {
+				config.TokenCommand = types.ListValueMust(types.StringType, []attr.Value{})
+			},
+			expectedErrorText: `The "token_command" must contain at least the executable.`,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			for _, env := range []string{"SNYK_TOKEN", "SNYK_TOKEN_FILE", "SNYK_TOKEN_COMMAND"} {
+				t.Setenv(env, test.env[env])
+			}
+			config := testProviderModel()
+			if test.config != nil {
+				test.config(&config)
+			}
+
+			actual, diags := (&snykProvider{}).resolveTokenConfig(context.Background(), config)
+
+			if test.expectedErrorText != "" {
+				require.True(t, diags.HasError())
+				assert.Equal(t, test.expectedErrorText, diags[0].Detail())
+				return
+			}
+			require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+func TestProvider_resolvedTokenConfigAPIToken(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("file-token\n"), 0o600))
+	emptyTokenFile := filepath.Join(t.TempDir(), "empty")
+	require.NoError(t, os.WriteFile(emptyTokenFile, []byte("  \n"), 0o600))
+	t.Setenv("SNYK_TEST_TOKEN_COMMAND", "1")
+
+	tests := map[string]struct {
+		tokenConfig       resolvedTokenConfig
+		expected          string
+		expectedErrorText string
+	}{
+		"token": {
+			tokenConfig: resolvedTokenConfig{token: "token", attributeName: "token"},
+			expected:    "token",
+		},
+		"token-file": {
+			tokenConfig: resolvedTokenConfig{file: tokenFile, attributeName: "token_file"},
+			expected:    "file-token",
+		},
+		"missing-token-file": {
+			tokenConfig:       resolvedTokenConfig{file: filepath.Join(t.TempDir(), "missing"), attributeName: "token_file"},
+			expectedErrorText: "Unable to read the token file",
+		},
+		"empty-token-file": {
+			tokenConfig:       resolvedTokenConfig{file: emptyTokenFile, attributeName: "token_file"},
+			expectedErrorText: "returned an empty token",
+		},
+		"token-command": {
+			tokenConfig: resolvedTokenConfig{command: testTokenCommand("print", "command-token"), attributeName: "token_command"},
+			expected:    "command-token",
+		},
+		"failing-token-command": {
+			tokenConfig:       resolvedTokenConfig{command: testTokenCommand("fail", "permission denied"), attributeName: "token_command"},
+			expectedErrorText: "exit status 1\n\npermission denied",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual, diags := test.tokenConfig.apiToken(context.Background())
+
+			if test.expectedErrorText != "" {
+				require.True(t, diags.HasError())
+				assert.Equal(t, path.Root(test.tokenConfig.attributeName), diags[0].(diag.DiagnosticWithPath).Path())
+				assert.Contains(t, diags[0].Detail(), test.expectedErrorText)
+				return
+			}
+			require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+			assert.Equal(t, test.expected, actual)
+		})
+	}
+}
+
+// TestProvider_TokenCommandHelperProcess isn't a real test, it's the fake token command started by testTokenCommand.
+func TestProvider_TokenCommandHelperProcess(_ *testing.T) {
+	if os.Getenv("SNYK_TEST_TOKEN_COMMAND") != "1" {
+		return
+	}
+	args := os.Args[slices.Index(os.Args, "--")+1:]
+	switch args[0] {
+	case "print":
+		_, _ = fmt.Fprintln(os.Stdout, args[1])
+		os.Exit(0)
+	case "fail":
+		_, _ = fmt.Fprintln(os.Stderr, args[1])
+		os.Exit(1)
+	}
+}
+
+// testTokenCommand returns the token command running the test binary as fake command.
+func testTokenCommand(args ...string) []string {
+	return append([]string{os.Args[0], "-test.run=^TestProvider_TokenCommandHelperProcess$", "--"}, args...)
+}
+
+func TestProvider_ConfigureReadsTokenFile(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/self" || r.Header.Get("Authorization") != "Token file-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{"data": {"id": "user-id", "type": "user", "attributes": {"name": "Test User"}}}`)
+	}))
+	t.Cleanup(server.Close)
+	t.Setenv("SNYK_APP_BASE_URL", server.URL+"/")
+	t.Setenv("SNYK_REST_BASE_URL", server.URL+"/rest/")
+	t.Setenv("SNYK_V1_BASE_URL", server.URL+"/v1/")
+	t.Setenv("SNYK_REGION", "SNYK-TEST-01")
+	t.Setenv("SNYK_TOKEN", "env-token")
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("file-token\n"), 0o600))
+
+	providerData := testConfigureProvider(t, map[string]tftypes.Value{
+		"token_file": tftypes.NewValue(tftypes.String, tokenFile),
+	})
+	user, _, err := providerData.client.Users.GetSelf(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, "user-id", user.ID)
+}
+
+func TestProvider_resolveHTTPConfig(t *testing.T) {
+	certPEM, keyPEM := testCertificatePEM(t)
+	caCertFile := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(caCertFile, []byte(certPEM), 0o600))
+
+	tests := map[string]struct {
+		config            func(c *snykProviderModel)
+		env               map[string]string
+		expectedProxyURL  string
+		expectedTLS       bool
+		expectedErrorText string
+	}{
+		"defaults": {},
+		"proxy-url": {
+			config:           func(c *snykProviderModel) { c.ProxyURL = types.StringValue("http://proxy.local:3128") },
+			expectedProxyURL: "http://proxy.local:3128",
+		},
+		"proxy-url-from-env": {
+			env:              map[string]string{"SNYK_PROXY_URL": "socks5://proxy.local:1080"},
+			expectedProxyURL: "socks5://proxy.local:1080",
+		},
+		"invalid-proxy-url": {
+			config:            func(c *snykProviderModel) { c.ProxyURL = types.StringValue("proxy.local:3128") },
+			expectedErrorText: `The "proxy_url" must be an absolute URL with "http", "https" or "socks5" scheme, got "proxy.local:3128".`,
+		},
+		"ca-cert-file": {
+			config:      func(c *snykProviderModel) { c.CACertFile = types.StringValue(caCertFile) },
+			expectedTLS: true,
+		},
+		"ca-cert-pem-from-env": {
+			env:         map[string]string{"SNYK_CA_CERT_PEM": certPEM},
+			expectedTLS: true,
+		},
+		"missing-ca-cert-file": {
+			config: func(c *snykProviderModel) {
+				c.CACertFile = types.StringValue(filepath.Join(t.TempDir(), "missing.pem"))
+			},
+			expectedErrorText: "Unable to read the CA bundle",
+		},
+		"invalid-ca-cert-pem": {
+			config:            func(c *snykProviderModel) { c.CACertPEM = types.StringValue("not a certificate") },
+			expectedErrorText: `The CA bundle must contain at least one PEM-encoded certificate.`,
+		},
+		"conflicting-ca-cert": {
+			config: func(c *snykProviderModel) {
+				c.CACertFile = types.StringValue(caCertFile)
+				c.CACertPEM = types.StringValue(certPEM)
+			},
+			expectedErrorText: `Only one of "ca_cert_file" and "ca_cert_pem" can be set`,
+		},
+		"insecure-skip-verify": {
+			config:      func(c *snykProviderModel) { c.InsecureSkipVerify = types.BoolValue(true) },
+			expectedTLS: true,
+		},
+		"insecure-skip-verify-disabled-in-hcl": {
+			config: func(c *snykProviderModel) { c.InsecureSkipVerify = types.BoolValue(false) },
+			env:    map[string]string{"SNYK_INSECURE_SKIP_VERIFY": "true"},
+		},
+		"invalid-env-insecure-skip-verify": {
+			env:               map[string]string{"SNYK_INSECURE_SKIP_VERIFY": "maybe"},
+			expectedErrorText: `The "SNYK_INSECURE_SKIP_VERIFY" environment variable must be a boolean, got "maybe".`,
+		},
+		"client-certificate": {
+			config: func(c *snykProviderModel) {
+				c.ClientCert = types.StringValue(certPEM)
+				c.ClientKey = types.StringValue(keyPEM)
+			},
+			expectedTLS: true,
+		},
+		"client-certificate-without-key": {
+			config:            func(c *snykProviderModel) { c.ClientCert = types.StringValue(certPEM) },
+			expectedErrorText: `The "client_cert" and "client_key" must both be set`,
+		},
+		"client-certificate-with-invalid-key": {
+			config: func(c *snykProviderModel) {
+				c.ClientCert = types.StringValue(certPEM)
+				c.ClientKey = types.StringValue("not a key")
+			},
+			expectedErrorText: `The "client_cert" and "client_key" must be a valid PEM-encoded certificate and private key`,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			for _, env := range []string{
+				"SNYK_CA_CERT_FILE", "SNYK_CA_CERT_PEM", "SNYK_CLIENT_CERT", "SNYK_CLIENT_KEY", "SNYK_INSECURE_SKIP_VERIFY", "SNYK_PROXY_URL",
+			} {
+				t.Setenv(env, test.env[env])
+			}
+			config := testProviderModel()
+			if test.config != nil {
+				test.config(&config)
+			}
+
+			actual, diags := (&snykProvider{}).resolveHTTPConfig(config)
+
+			if test.expectedErrorText != "" {
+				require.True(t, diags.HasError())
+				assert.Contains(t, diags[0].Detail(), test.expectedErrorText)
+				return
+			}
+			require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+			if test.expectedProxyURL != "" {
+				require.NotNil(t, actual.proxyURL)
+				assert.Equal(t, test.expectedProxyURL, actual.proxyURL.String())
+			} else {
+				assert.Nil(t, actual.proxyURL)
+			}
+			assert.Equal(t, test.expectedTLS, actual.tlsConfig != nil)
+		})
+	}
+}
+
+func TestProvider_ConfigureUsesCustomCAAndClientCertificate(t *testing.T) {
+	clientCertPEM, clientKeyPEM := testCertificatePEM(t)
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/self" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{"data": {"id": "user-id", "type": "user", "attributes": {"name": "Test User"}}}`)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert, MinVersion: tls.VersionTLS12}
+	server.StartTLS()
+	t.Cleanup(server.Close)
+	serverCertPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+	t.Setenv("SNYK_APP_BASE_URL", server.URL+"/")
+	t.Setenv("SNYK_REST_BASE_URL", server.URL+"/rest/")
+	t.Setenv("SNYK_V1_BASE_URL", server.URL+"/v1/")
+	t.Setenv("SNYK_REGION", "SNYK-TEST-01")
+	t.Setenv("SNYK_TOKEN", "test-token")
+
+	providerData := testConfigureProvider(t, map[string]tftypes.Value{
+		"ca_cert_pem": tftypes.NewValue(tftypes.String, serverCertPEM),
+		"client_cert": tftypes.NewValue(tftypes.String, clientCertPEM),
+		"client_key":  tftypes.NewValue(tftypes.String, clientKeyPEM),
+	})
+	user, _, err := providerData.client.Users.GetSelf(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, "user-id", user.ID)
+}
+
+func TestProvider_ConfigureUsesProxy(t *testing.T) {
+	var proxiedHost atomic.Value
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// a forward proxy receives the absolute URL of the target
+		proxiedHost.Store(r.URL.Host)
+		_, _ = fmt.Fprint(w, `{"data": {"id": "user-id", "type": "user", "attributes": {"name": "Test User"}}}`)
+	}))
+	t.Cleanup(proxy.Close)
+	t.Setenv("SNYK_APP_BASE_URL", "http://snyk.internal/")
+	t.Setenv("SNYK_REST_BASE_URL", "http://snyk.internal/rest/")
+	t.Setenv("SNYK_V1_BASE_URL", "http://snyk.internal/v1/")
+	t.Setenv("SNYK_REGION", "SNYK-TEST-01")
+	t.Setenv("SNYK_TOKEN", "test-token")
+
+	providerData := testConfigureProvider(t, map[string]tftypes.Value{
+		"proxy_url": tftypes.NewValue(tftypes.String, proxy.URL),
+	})
+	user, _, err := providerData.client.Users.GetSelf(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, "user-id", user.ID)
+	assert.Equal(t, "snyk.internal", proxiedHost.Load())
+}
+
+func TestProvider_ConfigureValidatesCredentials(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/rest/self" {
+			http.NotFound(w, r)
+			return
+		}
+		calls.Add(1)
+		_, _ = fmt.Fprint(w, `{"data": {"id": "user-id", "type": "user", "attributes": {"name": "Test User"}}}`)
+	}))
+	t.Cleanup(server.Close)
+	t.Setenv("SNYK_APP_BASE_URL", server.URL+"/")
+	t.Setenv("SNYK_REST_BASE_URL", server.URL+"/rest/")
+	t.Setenv("SNYK_V1_BASE_URL", server.URL+"/v1/")
+	t.Setenv("SNYK_REGION", "SNYK-TEST-01")
+	t.Setenv("SNYK_TOKEN", "test-token")
+	t.Setenv("SNYK_SKIP_CREDENTIALS_VALIDATION", "")
+
+	testConfigureProvider(t, map[string]tftypes.Value{
+		"skip_credentials_validation": tftypes.NewValue(tftypes.Bool, nil),
+	})
+
+	assert.EqualValues(t, 1, calls.Load())
+}
+
+func TestProvider_validateCredentials(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		statusCode        int
+		expectedErrorText string
+	}{
+		"valid": {
+			statusCode: http.StatusOK,
+		},
+		"unauthorized": {
+			statusCode:        http.StatusUnauthorized,
+			expectedErrorText: `for region "SNYK-TEST-01" (REST API %[1]s/rest/, V1 API %[1]s/v1/), HTTP status: 401 Unauthorized.`,
+		},
+		"not-found": {
+			statusCode:        http.StatusNotFound,
+			expectedErrorText: `for region "SNYK-TEST-01" (REST API %[1]s/rest/, V1 API %[1]s/v1/), HTTP status: 404 Not Found.`,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("/rest/self", func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(test.statusCode)
+				_, _ = fmt.Fprint(w, `{"data": {"id": "user-id", "type": "user", "attributes": {"name": "Test User"}}}`)
+			})
+			server := httptest.NewServer(mux)
+			t.Cleanup(server.Close)
+			region := snyk.Region{
+				Alias:       "SNYK-TEST-01",
+				AppBaseURL:  server.URL + "/",
+				RESTBaseURL: server.URL + "/rest/",
+				V1BaseURL:   server.URL + "/v1/",
+			}
+			client, err := snyk.NewClient("test-token", snyk.WithRegion(region))
+			require.NoError(t, err)
+
+			diags := validateCredentials(context.Background(), client, region)
+
+			if test.expectedErrorText != "" {
+				require.True(t, diags.HasError())
+				assert.Equal(t, "Unable to validate Snyk credentials", diags[0].Summary())
+				assert.Contains(t, diags[0].Detail(), fmt.Sprintf(test.expectedErrorText, server.URL))
+				return
+			}
+			assert.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+		})
+	}
+}
+
+// testCertificatePEM returns a PEM-encoded self-signed certificate and its private key.
+func testCertificatePEM(t *testing.T) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "terraform-provider-snyk-test"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}))
+}
+
+// testProviderModel returns a provider model with all attributes unset.
+func testProviderModel() snykProviderModel {
+	return snykProviderModel{
+		CACertFile:                types.StringNull(),
+		CACertPEM:                 types.StringNull(),
+		ClientCert:                types.StringNull(),
+		ClientKey:                 types.StringNull(),
+		DefaultGroupID:            types.StringNull(),
+		DefaultOrganizationID:     types.StringNull(),
+		DefaultTenantID:           types.StringNull(),
+		InsecureSkipVerify:        types.BoolNull(),
+		MaxConcurrentRequests:     types.Int64Null(),
+		MaxRetries:                types.Int64Null(),
+		OAuth:                     types.ObjectNull(map[string]attr.Type{}),
+		ProxyURL:                  types.StringNull(),
+		Region:                    types.ObjectNull(map[string]attr.Type{}),
+		RequestTimeout:            types.StringNull(),
+		RequestsPerSecond:         types.Float64Null(),
+		RetryMaxWait:              types.StringNull(),
+		RetryMinWait:              types.StringNull(),
+		SkipCredentialsValidation: types.BoolNull(),
+		Token:                     types.StringNull(),
+		TokenCommand:              types.ListNull(types.StringType),
+		TokenFile:                 types.StringNull(),
+	}
+}
+
+// testConfigureProvider configures the provider with the given attributes, all other attributes are null.
+// The credentials validation is skipped unless "skip_credentials_validation" is given.
+func testConfigureProvider(t *testing.T, attributes map[string]tftypes.Value) *snykProviderData {
+	t.Helper()
+	ctx := context.Background()
+
+	p := &snykProvider{version: "test"}
+	schemaResponse := &provider.SchemaResponse{}
+	p.Schema(ctx, provider.SchemaRequest{}, schemaResponse)
+	require.False(t, schemaResponse.Diagnostics.HasError())
+
+	objectType := schemaResponse.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	values["skip_credentials_validation"] = tftypes.NewValue(tftypes.Bool, true)
+	for name, value := range attributes {
+		values[name] = value
+	}
+	config := tfsdk.Config{Schema: schemaResponse.Schema, Raw: tftypes.NewValue(objectType, values)}
+
+	validateResponse := &provider.ValidateConfigResponse{}
+	p.ValidateConfig(ctx, provider.ValidateConfigRequest{Config: config}, validateResponse)
+	require.False(t, validateResponse.Diagnostics.HasError(), "unexpected diagnostics: %v", validateResponse.Diagnostics)
+
+	configureResponse := &provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{Config: config}, configureResponse)
+	require.False(t, configureResponse.Diagnostics.HasError(), "unexpected diagnostics: %v", configureResponse.Diagnostics)
+
+	providerData, ok := configureResponse.ResourceData.(*snykProviderData)
+	require.True(t, ok)
+	return providerData
+}
+
+// testDataSourceConfig builds the data source configuration with the given string attributes set,
+// all other attributes are null.
+func testDataSourceConfig(t *testing.T, d fwdatasource.DataSource, attributes map[string]string) tfsdk.Config {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResponse := &fwdatasource.SchemaResponse{}
+	d.Schema(ctx, fwdatasource.SchemaRequest{}, schemaResponse)
+	require.False(t, schemaResponse.Diagnostics.HasError())
+
+	objectType, ok := schemaResponse.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	require.True(t, ok)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	for name, value := range attributes {
+		values[name] = tftypes.NewValue(tftypes.String, value)
+	}
+
+	return tfsdk.Config{Schema: schemaResponse.Schema, Raw: tftypes.NewValue(objectType, values)}
+}
+
+// testValidateDataSourceConfig validates the data source configuration through the provider server,
+// which runs the schema and attribute validators like Terraform does.
+func testValidateDataSourceConfig(t *testing.T, typeName string, config tfsdk.Config) []*tfprotov6.Diagnostic {
+	t.Helper()
+	ctx := context.Background()
+
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	require.NoError(t, err)
+	dynamicValue, err := tfprotov6.NewDynamicValue(config.Schema.Type().TerraformType(ctx), config.Raw)
+	require.NoError(t, err)
+
+	response, err := server.ValidateDataResourceConfig(ctx, &tfprotov6.ValidateDataResourceConfigRequest{
+		TypeName: typeName,
+		Config:   &dynamicValue,
+	})
+	require.NoError(t, err)
+
+	return response.Diagnostics
+}
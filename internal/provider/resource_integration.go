@@ -1,5 +1,9 @@
 package provider
 
+// The integration resource is disabled until snyk-sdk-go/v2 provides the org integrations
+// endpoints (list, create, update, settings and credentials removal) again. The code below
+// still targets the v1 SDK and the old tfsdk.Schema API.
+
 //import (
 //	"context"
 //
@@ -18,7 +18,8 @@ var (
 
 // appInstallDataSource defines the app installation datasource implementation.
 type appInstallDataSource struct {
-	client *snyk.Client
+	client       *snyk.Client
+	defaultOrgID string
 }
 
 // appInstallDataSourceModel describes the datasource data model.
@@ -68,8 +69,9 @@ powered by OAuth 2.0 for a developer-friendly experience. See (Snyk Apps)[https:
 				Optional:            true,
 			},
 			"organization_id": schema.StringAttribute{
-				MarkdownDescription: "The organization ID of the app installation.",
-				Required:            true,
+				MarkdownDescription: "The organization ID of the app installation. Defaults to the `default_organization_id` of the provider.",
+				Computed:            true,
+				Optional:            true,
 			},
 		},
 	}
@@ -80,7 +82,7 @@ func (d *appInstallDataSource) Configure(_ context.Context, request datasource.C
 		return
 	}
 
-	client, ok := request.ProviderData.(*snyk.Client)
+	providerData, ok := request.ProviderData.(*snykProviderData)
 	if !ok {
 		response.Diagnostics.AddError(
 			"Unconfigured Snyk client",
@@ -89,7 +91,8 @@ func (d *appInstallDataSource) Configure(_ context.Context, request datasource.C
 		return
 	}
 
-	d.client = client
+	d.client = providerData.client
+	d.defaultOrgID = providerData.defaultOrgID
 }
 
 func (d *appInstallDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
@@ -111,6 +114,16 @@ func (d *appInstallDataSource) Read(ctx context.Context, request datasource.Read
 		return
 	}
 	orgID := data.OrganizationID.ValueString()
+	if data.OrganizationID.IsNull() {
+		orgID = d.defaultOrgID
+	}
+	if orgID == "" {
+		response.Diagnostics.AddError(
+			"Missing required attributes",
+			`The attribute "organization_id" or the provider attribute "default_organization_id" must be defined.`,
+		)
+		return
+	}
 
 	tflog.Trace(ctx, "Getting app installs for organization", map[string]any{"organization_id": orgID})
 	appInstalls, resp, err := d.client.Apps.ListAppInstallsForOrg(ctx, orgID, nil)
@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pavel-snyk/snyk-sdk-go/v2/snyk"
+)
+
+var (
+	_ datasource.DataSource              = (*groupDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*groupDataSource)(nil)
+)
+
+// groupDataSource is the group datasource implementation.
+type groupDataSource struct {
+	client         *snyk.Client
+	defaultGroupID string
+}
+
+// groupDataSourceModel maps the group datasource schema data.
+type groupDataSourceModel struct {
+	CreatedAt types.String `tfsdk:"created_at"`
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Slug      types.String `tfsdk:"slug"`
+	TenantID  types.String `tfsdk:"tenant_id"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+func NewGroupDataSource() datasource.DataSource {
+	return &groupDataSource{}
+}
+
+func (d *groupDataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "snyk_group"
+}
+
+func (d *groupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: `
+The group data source provides information about an existing Snyk group.
+
+A Group in Snyk is a way to manage multiple Organizations and apply settings and policies across them.
+See [Manage Groups and Organizations](https://docs.snyk.io/snyk-platform-administration/groups-and-organizations).
+`,
+		Attributes: map[string]schema.Attribute{
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The time the group was created.",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the group. Defaults to the `default_group_id` of the provider if `name` is not set.",
+				Computed:            true,
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the group.",
+				Computed:            true,
+				Optional:            true,
+			},
+			"slug": schema.StringAttribute{
+				MarkdownDescription: "The canonical (unique and URL-friendly) name of the group.",
+				Computed:            true,
+			},
+			"tenant_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the tenant to which the group belongs.",
+				Computed:            true,
+			},
+			"updated_at": schema.StringAttribute{
+				MarkdownDescription: "The time the group was last modified.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *groupDataSource) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := request.ProviderData.(*snykProviderData)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unconfigured Snyk client",
+			"Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	d.client = providerData.client
+	d.defaultGroupID = providerData.defaultGroupID
+}
+
+func (d *groupDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data groupDataSourceModel
+
+	diags := request.Config.Get(ctx, &data)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	groupID := data.ID.ValueString()
+	groupName := data.Name.ValueString()
+	if groupID == "" && groupName == "" {
+		groupID = d.defaultGroupID
+	}
+	if groupID == "" && groupName == "" {
+		response.Diagnostics.AddError(
+			"Missing required attributes",
+			`The attribute "id", "name" or the provider attribute "default_group_id" must be defined.`,
+		)
+		return
+	}
+
+	if groupID == "" {
+		tflog.Info(ctx, "Searching for group by name", map[string]any{"group_name": groupName})
+
+		tflog.Debug(ctx, "Getting all groups", map[string]any{"group_name": groupName})
+		groups, errf := d.client.Groups.All(ctx, nil)
+		var matchedIDs []string
+		for group := range groups {
+			if groupName == group.Attributes.Name {
+				tflog.Info(ctx, "Found group by name", map[string]any{"group_name": groupName, "data": group})
+				matchedIDs = append(matchedIDs, group.ID)
+			}
+		}
+		if err := errf(); err != nil {
+			response.Diagnostics.AddError("Unable to get groups", err.Error())
+			return
+		}
+		switch len(matchedIDs) {
+		case 0:
+			response.Diagnostics.AddError("No search results", "Please refine your search.")
+			return
+		case 1:
+			groupID = matchedIDs[0]
+		default:
+			response.Diagnostics.AddError(
+				"Ambiguous search results",
+				fmt.Sprintf("Found %d groups with the name %q (%s). Please refine your search with \"id\".",
+					len(matchedIDs), groupName, strings.Join(matchedIDs, ", ")),
+			)
+			return
+		}
+	}
+
+	// the list API exposes only the name, so the group is always fetched by id
+	tflog.Debug(ctx, "Getting group by id", map[string]any{"group_id": groupID})
+	group, resp, err := d.client.Groups.Get(ctx, groupID)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			response.Diagnostics.AddError("No search results", "Please refine your search.")
+			return
+		}
+		response.Diagnostics.AddError("Unable to get group", err.Error())
+		return
+	}
+	tflog.Debug(ctx, "Got group", map[string]any{"data": group})
+
+	// if name is defined check that it's equal
+	if groupName != "" && groupName != group.Attributes.Name {
+		response.Diagnostics.AddError(
+			"Ambiguous search results",
+			fmt.Sprintf("Specified and actual group name are different: expected '%s', got '%s'", groupName, group.Attributes.Name),
+		)
+		return
+	}
+
+	// map response body to attributes
+	data.CreatedAt = types.StringNull()
+	if !group.Attributes.CreatedAt.IsZero() {
+		data.CreatedAt = types.StringValue(group.Attributes.CreatedAt.Format(time.RFC3339))
+	}
+	data.ID = types.StringValue(group.ID)
+	data.Name = types.StringValue(group.Attributes.Name)
+	data.Slug = types.StringValue(group.Attributes.Slug)
+	data.TenantID = types.StringNull()
+	if group.Relationships != nil && group.Relationships.Tenant != nil && group.Relationships.Tenant.Data != nil {
+		data.TenantID = types.StringValue(group.Relationships.Tenant.Data.ID)
+	}
+	data.UpdatedAt = types.StringNull()
+	if !group.Attributes.UpdatedAt.IsZero() {
+		data.UpdatedAt = types.StringValue(group.Attributes.UpdatedAt.Format(time.RFC3339))
+	}
+
+	diags = response.State.Set(ctx, &data)
+	response.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	fwdatasource "github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccSnykGroupDataSource(t *testing.T) {
+	t.Parallel()
+
+	groupID := accTestGroupID()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: fmt.Sprintf(`
+data "snyk_group" "test" {
+  id = %[1]q
+}
+`, groupID),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"data.snyk_group.test",
+						tfjsonpath.New("id"),
+						knownvalue.StringExact(groupID),
+					),
+					statecheck.ExpectKnownValue(
+						"data.snyk_group.test",
+						tfjsonpath.New("name"),
+						knownvalue.NotNull(),
+					),
+					statecheck.ExpectKnownValue(
+						"data.snyk_group.test",
+						tfjsonpath.New("tenant_id"),
+						knownvalue.NotNull(),
+					),
+				},
+			},
+		},
+	})
+}
+
+func TestGroupDataSource_Read(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		config            map[string]string
+		defaultGroupID    string
+		expectedErrorText string
+	}{
+		"by-id": {
+			config: map[string]string{"id": "group-id"},
+		},
+		"by-name": {
+			config: map[string]string{"name": "Platform"},
+		},
+		"by-id-and-name": {
+			config: map[string]string{"id": "group-id", "name": "Platform"},
+		},
+		"by-provider-default": {
+			config:         map[string]string{},
+			defaultGroupID: "group-id",
+		},
+		"by-id-with-different-name": {
+			config:            map[string]string{"id": "group-id", "name": "Security"},
+			expectedErrorText: "Ambiguous search results",
+		},
+		"by-unknown-id": {
+			config:            map[string]string{"id": "unknown"},
+			expectedErrorText: "No search results",
+		},
+		"by-unknown-name": {
+			config:            map[string]string{"name": "Security"},
+			expectedErrorText: "No search results",
+		},
+		"by-duplicate-name": {
+			config:            map[string]string{"name": "Shared"},
+			expectedErrorText: "Ambiguous search results",
+		},
+		"without-filters": {
+			config:            map[string]string{},
+			expectedErrorText: "Missing required attributes",
+		},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/groups", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = fmt.Fprint(w, `
+{
+  "data": [
+    {"id": "other-group-id", "type": "group", "attributes": {"name": "Other"}},
+    {"id": "group-id", "type": "group", "attributes": {"name": "Platform"}},
+    {"id": "shared-group-id", "type": "group", "attributes": {"name": "Shared"}},
+    {"id": "another-shared-group-id", "type": "group", "attributes": {"name": "Shared"}}
+  ]
+}`)
+	})
+	mux.HandleFunc("/rest/groups/group-id", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = fmt.Fprint(w, `
+{
+  "data": {
+    "id": "group-id",
+    "type": "group",
+    "attributes": {
+      "created_at": "2025-01-02T03:04:05Z",
+      "name": "Platform",
+      "slug": "platform",
+      "updated_at": "2025-02-03T04:05:06Z"
+    },
+    "relationships": {
+      "tenant": {"data": {"id": "tenant-id", "type": "tenant"}}
+    }
+  }
+}`)
+	})
+	mux.HandleFunc("/rest/groups/unknown", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			d := &groupDataSource{client: newTestClient(t, mux), defaultGroupID: test.defaultGroupID}
+			config := testDataSourceConfig(t, d, test.config)
+
+			response := &fwdatasource.ReadResponse{State: tfsdk.State{Schema: config.Schema, Raw: config.Raw.Copy()}}
+			d.Read(ctx, fwdatasource.ReadRequest{Config: config}, response)
+
+			if test.expectedErrorText != "" {
+				require.True(t, response.Diagnostics.HasError())
+				assert.Equal(t, test.expectedErrorText, response.Diagnostics[0].Summary())
+				return
+			}
+			require.False(t, response.Diagnostics.HasError(), "unexpected diagnostics: %v", response.Diagnostics)
+
+			var data groupDataSourceModel
+			response.Diagnostics.Append(response.State.Get(ctx, &data)...)
+			require.False(t, response.Diagnostics.HasError(), "unexpected diagnostics: %v", response.Diagnostics)
+			assert.Equal(t, "group-id", data.ID.ValueString())
+			assert.Equal(t, "Platform", data.Name.ValueString())
+			assert.Equal(t, "platform", data.Slug.ValueString())
+			assert.Equal(t, "tenant-id", data.TenantID.ValueString())
+			assert.Equal(t, "2025-01-02T03:04:05Z", data.CreatedAt.ValueString())
+			assert.Equal(t, "2025-02-03T04:05:06Z", data.UpdatedAt.ValueString())
+		})
+	}
+}
@@ -20,7 +20,8 @@ var (
 
 // organizationDataSource is the organization datasource implementation.
 type organizationDataSource struct {
-	client *snyk.Client
+	client       *snyk.Client
+	defaultOrgID string
 }
 
 // organizationDataSourceModel maps the organization datasource schema data.
@@ -54,7 +55,7 @@ have access to these Projects. See [Manage Groups and Organizations](https://doc
 				Computed:            true,
 			},
 			"id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the organization.",
+				MarkdownDescription: "The ID of the organization. Defaults to the `default_organization_id` of the provider if `name` is not set.",
 				Computed:            true,
 				Optional:            true,
 			},
@@ -80,7 +81,7 @@ func (d *organizationDataSource) Configure(_ context.Context, request datasource
 		return
 	}
 
-	client, ok := request.ProviderData.(*snyk.Client)
+	providerData, ok := request.ProviderData.(*snykProviderData)
 	if !ok {
 		response.Diagnostics.AddError(
 			"Unconfigured Snyk client",
@@ -89,7 +90,8 @@ func (d *organizationDataSource) Configure(_ context.Context, request datasource
 		return
 	}
 
-	d.client = client
+	d.client = providerData.client
+	d.defaultOrgID = providerData.defaultOrgID
 }
 
 func (d *organizationDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
@@ -103,10 +105,13 @@ func (d *organizationDataSource) Read(ctx context.Context, request datasource.Re
 
 	orgID := data.ID.ValueString()
 	orgName := data.Name.ValueString()
+	if orgID == "" && orgName == "" {
+		orgID = d.defaultOrgID
+	}
 	if orgID == "" && orgName == "" {
 		response.Diagnostics.AddError(
 			"Missing required attributes",
-			`The attribute "id" or "name" must be defined.`,
+			`The attribute "id", "name" or the provider attribute "default_organization_id" must be defined.`,
 		)
 		return
 	}
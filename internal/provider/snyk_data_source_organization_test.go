@@ -66,7 +66,7 @@ func TestAccSnykOrganizationDataSource_expectError(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config:      testAccSnykOrganizationDataSourceConfigWithoutIDAndName,
-				ExpectError: regexp.MustCompile(`The attribute "id" or "name" must be defined`),
+				ExpectError: regexp.MustCompile(`The attribute "id", "name" or the provider attribute\s+"default_organization_id" must be defined`),
 			},
 		},
 	})
@@ -0,0 +1,229 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pavel-snyk/snyk-sdk-go/v2/snyk"
+)
+
+var (
+	_ datasource.DataSource              = (*organizationsDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*organizationsDataSource)(nil)
+)
+
+// organizationsDataSource is the organizations datasource implementation.
+type organizationsDataSource struct {
+	client *snyk.Client
+}
+
+// organizationsDataSourceModel maps the organizations datasource schema data.
+type organizationsDataSourceModel struct {
+	GroupID       types.String                  `tfsdk:"group_id"`
+	NamePrefix    types.String                  `tfsdk:"name_prefix"`
+	NameRegex     types.String                  `tfsdk:"name_regex"`
+	Organizations []organizationDataSourceModel `tfsdk:"organizations"`
+	Slug          types.String                  `tfsdk:"slug"`
+	TenantID      types.String                  `tfsdk:"tenant_id"`
+}
+
+func NewOrganizationsDataSource() datasource.DataSource {
+	return &organizationsDataSource{}
+}
+
+func (d *organizationsDataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "snyk_organizations"
+}
+
+func (d *organizationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: `
+The organizations data source provides information about all accessible Snyk organizations matching the given filters.
+
+An Organization in Snyk is a way to collect and organize your Projects. Members of Organizations
+have access to these Projects. See [Manage Groups and Organizations](https://docs.snyk.io/snyk-platform-administration/groups-and-organizations).
+`,
+		Attributes: map[string]schema.Attribute{
+			"group_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the group to which the organizations belong.",
+				Optional:            true,
+			},
+			"name_prefix": schema.StringAttribute{
+				MarkdownDescription: "The prefix of the organization names.",
+				Optional:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "The regular expression matching the organization names, e.g. `^(frontend|backend)-`. " +
+					"See the [syntax](https://pkg.go.dev/regexp/syntax) of regular expressions.",
+				Optional: true,
+			},
+			"organizations": schema.ListNestedAttribute{
+				MarkdownDescription: "The organizations matching all filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"group_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the group to which the organization belongs.",
+							Computed:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the organization.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the organization.",
+							Computed:            true,
+						},
+						"slug": schema.StringAttribute{
+							MarkdownDescription: "The canonical (unique and URL-friendly) name of the organization.",
+							Computed:            true,
+						},
+						"tenant_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the tenant to which the organization belongs.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"slug": schema.StringAttribute{
+				MarkdownDescription: "The canonical (unique and URL-friendly) name of the organization.",
+				Optional:            true,
+			},
+			"tenant_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the tenant to which the organizations belong.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (d *organizationsDataSource) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := request.ProviderData.(*snykProviderData)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unconfigured Snyk client",
+			"Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	d.client = providerData.client
+}
+
+func (d *organizationsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data organizationsDataSourceModel
+
+	diags := request.Config.Get(ctx, &data)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			response.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid regular expression",
+				fmt.Sprintf("Unable to parse the name regex %q: %s", data.NameRegex.ValueString(), err),
+			)
+			return
+		}
+	}
+
+	groupID := data.GroupID.ValueString()
+	tflog.Debug(ctx, "Getting all accessible organizations", map[string]any{"group_id": groupID})
+	organizations, err := listAllOrganizations(ctx, d.client, groupID)
+	if err != nil {
+		response.Diagnostics.AddError("Unable to get organizations", err.Error())
+		return
+	}
+
+	data.Organizations = []organizationDataSourceModel{}
+	tenantIDs := map[string]types.String{}
+	for _, organization := range organizations {
+		if !data.NamePrefix.IsNull() && !strings.HasPrefix(organization.Attributes.Name, data.NamePrefix.ValueString()) {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(organization.Attributes.Name) {
+			continue
+		}
+		if !data.Slug.IsNull() && organization.Attributes.Slug != data.Slug.ValueString() {
+			continue
+		}
+
+		tenantID, err := d.organizationTenantID(ctx, organization, tenantIDs)
+		if err != nil {
+			response.Diagnostics.AddError("Unable to get organization", err.Error())
+			return
+		}
+		if !data.TenantID.IsNull() && tenantID.ValueString() != data.TenantID.ValueString() {
+			continue
+		}
+
+		// map response body to attributes
+		data.Organizations = append(data.Organizations, organizationDataSourceModel{
+			GroupID:  types.StringValue(organization.Attributes.GroupID),
+			ID:       types.StringValue(organization.ID),
+			Name:     types.StringValue(organization.Attributes.Name),
+			Slug:     types.StringValue(organization.Attributes.Slug),
+			TenantID: tenantID,
+		})
+	}
+	tflog.Info(ctx, "Found organizations", map[string]any{"count": len(data.Organizations)})
+
+	diags = response.State.Set(ctx, &data)
+	response.Diagnostics.Append(diags...)
+}
+
+// organizationTenantID returns the tenant ID of the organization.
+//
+// The list API doesn't expose the tenant, so it costs an additional request to get the organization.
+// All organizations of a group belong to the tenant of the group, so tenantIDs caches the tenant
+// by group ID and only one organization per group and every organization without a group is fetched.
+func (d *organizationsDataSource) organizationTenantID(ctx context.Context, organization snyk.Organization, tenantIDs map[string]types.String) (types.String, error) {
+	if organization.Relationships != nil && organization.Relationships.Tenant != nil && organization.Relationships.Tenant.Data != nil {
+		return types.StringValue(organization.Relationships.Tenant.Data.ID), nil
+	}
+	groupID := organization.Attributes.GroupID
+	if tenantID, ok := tenantIDs[groupID]; ok && groupID != "" {
+		return tenantID, nil
+	}
+
+	tflog.Debug(ctx, "Getting organization by id", map[string]any{"organization_id": organization.ID})
+	org, _, err := d.client.Orgs.Get(ctx, organization.ID, nil)
+	if err != nil {
+		return types.StringNull(), err
+	}
+	tflog.Debug(ctx, "Got organization by id", map[string]any{"organization_id": org.ID, "data": org})
+
+	tenantID := types.StringNull()
+	if org.Relationships != nil && org.Relationships.Tenant != nil && org.Relationships.Tenant.Data != nil {
+		tenantID = types.StringValue(org.Relationships.Tenant.Data.ID)
+	}
+	if groupID != "" {
+		tenantIDs[groupID] = tenantID
+	}
+	return tenantID, nil
+}
+
+// listAllOrganizations returns all accessible organizations, optionally only within the given group.
+func listAllOrganizations(ctx context.Context, client *snyk.Client, groupID string) ([]snyk.Organization, error) {
+	return listAllPages(ctx, func(ctx context.Context, opts snyk.ListOptions) ([]snyk.Organization, *snyk.Response, error) {
+		return client.Orgs.ListAccessibleOrgs(ctx, &snyk.ListOrganizationOptions{ListOptions: opts, GroupID: groupID})
+	})
+}
@@ -0,0 +1,228 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	fwdatasource "github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrganizationsDataSource_Read(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		config            map[string]string
+		expectedIDs       []string
+		expectedErrorText string
+	}{
+		"without-filters": {
+			config:      map[string]string{},
+			expectedIDs: []string{"org-frontend", "org-backend", "org-personal"},
+		},
+		"by-group-id": {
+			config:      map[string]string{"group_id": "group-id"},
+			expectedIDs: []string{"org-frontend", "org-backend"},
+		},
+		"by-name-prefix": {
+			config:      map[string]string{"name_prefix": "team-"},
+			expectedIDs: []string{"org-frontend", "org-backend"},
+		},
+		"by-name-regex": {
+			config:      map[string]string{"name_regex": "(?i)BACKEND$"},
+			expectedIDs: []string{"org-backend"},
+		},
+		"by-slug": {
+			config:      map[string]string{"slug": "team-frontend"},
+			expectedIDs: []string{"org-frontend"},
+		},
+		"by-tenant-id": {
+			config:      map[string]string{"tenant_id": "tenant-id"},
+			expectedIDs: []string{"org-frontend", "org-backend"},
+		},
+		"no-matches": {
+			config:      map[string]string{"name_prefix": "team-", "tenant_id": "other-tenant-id"},
+			expectedIDs: []string{},
+		},
+		"invalid-name-regex": {
+			config:            map[string]string{"name_regex": "team-("},
+			expectedErrorText: "Invalid regular expression",
+		},
+	}
+
+	organizations := map[string]string{
+		"org-frontend": `{"id": "org-frontend", "type": "org", "attributes": {"name": "team-frontend", "slug": "team-frontend", "group_id": "group-id"}%s}`,
+		"org-backend":  `{"id": "org-backend", "type": "org", "attributes": {"name": "team-backend", "slug": "team-backend", "group_id": "group-id"}%s}`,
+		"org-personal": `{"id": "org-personal", "type": "org", "attributes": {"name": "personal", "slug": "personal", "is_personal": true}%s}`,
+	}
+	tenantRelationship := `, "relationships": {"tenant": {"data": {"id": "tenant-id", "type": "tenant"}}}`
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/orgs", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("group_id") == "group-id" {
+			_, _ = fmt.Fprintf(w, `{"data": [%s, %s]}`,
+				fmt.Sprintf(organizations["org-frontend"], ""), fmt.Sprintf(organizations["org-backend"], ""))
+			return
+		}
+		if r.URL.Query().Get("starting_after") == "" {
+			_, _ = fmt.Fprintf(w, `{"data": [%s, %s], "links": {"next": "/orgs?starting_after=cursor-1&version=2025-11-05"}}`,
+				fmt.Sprintf(organizations["org-frontend"], ""), fmt.Sprintf(organizations["org-backend"], ""))
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"data": [%s], "links": {}}`, fmt.Sprintf(organizations["org-personal"], ""))
+	})
+	mux.HandleFunc("/rest/orgs/{id}", func(w http.ResponseWriter, r *http.Request) {
+		organization, ok := organizations[r.PathValue("id")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		relationships := ""
+		if strings.Contains(organization, "group_id") {
+			relationships = tenantRelationship
+		}
+		_, _ = fmt.Fprintf(w, `{"data": %s}`, fmt.Sprintf(organization, relationships))
+	})
+	d := &organizationsDataSource{client: newTestClient(t, mux)}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			config := testDataSourceConfig(t, d, test.config)
+
+			response := &fwdatasource.ReadResponse{State: tfsdk.State{Schema: config.Schema, Raw: config.Raw.Copy()}}
+			d.Read(ctx, fwdatasource.ReadRequest{Config: config}, response)
+
+			if test.expectedErrorText != "" {
+				require.True(t, response.Diagnostics.HasError())
+				assert.Equal(t, test.expectedErrorText, response.Diagnostics[0].Summary())
+				return
+			}
+			require.False(t, response.Diagnostics.HasError(), "unexpected diagnostics: %v", response.Diagnostics)
+
+			var data organizationsDataSourceModel
+			response.Diagnostics.Append(response.State.Get(ctx, &data)...)
+			require.False(t, response.Diagnostics.HasError(), "unexpected diagnostics: %v", response.Diagnostics)
+			actualIDs := make([]string, 0, len(data.Organizations))
+			for _, organization := range data.Organizations {
+				actualIDs = append(actualIDs, organization.ID.ValueString())
+			}
+			assert.Equal(t, test.expectedIDs, actualIDs)
+		})
+	}
+}
+
+func TestOrganizationsDataSource_ReadMapsOrganization(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/orgs", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = fmt.Fprint(w, `{"data": [{"id": "org-id", "type": "org", "attributes": {"name": "Frontend", "slug": "frontend", "group_id": "group-id"}}]}`)
+	})
+	mux.HandleFunc("/rest/orgs/org-id", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "tenant", r.URL.Query().Get("expand"))
+		_, _ = fmt.Fprint(w, `
+{
+  "data": {
+    "id": "org-id",
+    "type": "org",
+    "attributes": {"name": "Frontend", "slug": "frontend", "group_id": "group-id"},
+    "relationships": {"tenant": {"data": {"id": "tenant-id", "type": "tenant"}}}
+  }
+}`)
+	})
+	ctx := context.Background()
+	d := &organizationsDataSource{client: newTestClient(t, mux)}
+	config := testDataSourceConfig(t, d, map[string]string{})
+
+	response := &fwdatasource.ReadResponse{State: tfsdk.State{Schema: config.Schema, Raw: config.Raw.Copy()}}
+	d.Read(ctx, fwdatasource.ReadRequest{Config: config}, response)
+	require.False(t, response.Diagnostics.HasError(), "unexpected diagnostics: %v", response.Diagnostics)
+
+	var data organizationsDataSourceModel
+	response.Diagnostics.Append(response.State.Get(ctx, &data)...)
+	require.False(t, response.Diagnostics.HasError(), "unexpected diagnostics: %v", response.Diagnostics)
+	require.Len(t, data.Organizations, 1)
+	assert.Equal(t, "group-id", data.Organizations[0].GroupID.ValueString())
+	assert.Equal(t, "org-id", data.Organizations[0].ID.ValueString())
+	assert.Equal(t, "Frontend", data.Organizations[0].Name.ValueString())
+	assert.Equal(t, "frontend", data.Organizations[0].Slug.ValueString())
+	assert.Equal(t, "tenant-id", data.Organizations[0].TenantID.ValueString())
+}
+
+func TestOrganizationsDataSource_ReadGetsTenantOncePerGroup(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		config          map[string]string
+		expectedGetURLs []string
+	}{
+		"without-filters": {
+			config:          map[string]string{},
+			expectedGetURLs: []string{"/rest/orgs/org-frontend", "/rest/orgs/org-personal"},
+		},
+		"by-name-prefix": {
+			config:          map[string]string{"name_prefix": "team-backend"},
+			expectedGetURLs: []string{"/rest/orgs/org-backend"},
+		},
+		"by-slug-with-tenant-in-list": {
+			config:          map[string]string{"slug": "platform"},
+			expectedGetURLs: []string{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var getURLs []string
+			var mutex sync.Mutex
+			mux := http.NewServeMux()
+			mux.HandleFunc("/rest/orgs", func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = fmt.Fprint(w, `
+{
+  "data": [
+    {"id": "org-frontend", "type": "org", "attributes": {"name": "team-frontend", "slug": "team-frontend", "group_id": "group-id"}},
+    {"id": "org-backend", "type": "org", "attributes": {"name": "team-backend", "slug": "team-backend", "group_id": "group-id"}},
+    {"id": "org-personal", "type": "org", "attributes": {"name": "personal", "slug": "personal", "is_personal": true}},
+    {
+      "id": "org-platform",
+      "type": "org",
+      "attributes": {"name": "platform", "slug": "platform", "group_id": "other-group-id"},
+      "relationships": {"tenant": {"data": {"id": "other-tenant-id", "type": "tenant"}}}
+    }
+  ]
+}`)
+			})
+			mux.HandleFunc("/rest/orgs/{id}", func(w http.ResponseWriter, r *http.Request) {
+				mutex.Lock()
+				getURLs = append(getURLs, r.URL.Path)
+				mutex.Unlock()
+				_, _ = fmt.Fprintf(w, `{"data": {"id": %q, "type": "org", "attributes": {}, "relationships": {"tenant": {"data": {"id": "tenant-id", "type": "tenant"}}}}}`, r.PathValue("id"))
+			})
+			ctx := context.Background()
+			d := &organizationsDataSource{client: newTestClient(t, mux)}
+			config := testDataSourceConfig(t, d, test.config)
+
+			response := &fwdatasource.ReadResponse{State: tfsdk.State{Schema: config.Schema, Raw: config.Raw.Copy()}}
+			d.Read(ctx, fwdatasource.ReadRequest{Config: config}, response)
+			require.False(t, response.Diagnostics.HasError(), "unexpected diagnostics: %v", response.Diagnostics)
+
+			var data organizationsDataSourceModel
+			response.Diagnostics.Append(response.State.Get(ctx, &data)...)
+			require.False(t, response.Diagnostics.HasError(), "unexpected diagnostics: %v", response.Diagnostics)
+			for _, organization := range data.Organizations {
+				expectedTenantID := "tenant-id"
+				if organization.ID.ValueString() == "org-platform" {
+					expectedTenantID = "other-tenant-id"
+				}
+				assert.Equal(t, expectedTenantID, organization.TenantID.ValueString(), "unexpected tenant of %s", organization.ID)
+			}
+			assert.ElementsMatch(t, test.expectedGetURLs, getURLs)
+		})
+	}
+}
@@ -0,0 +1,253 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pavel-snyk/snyk-sdk-go/v2/snyk"
+
+	snykvalidator "github.com/pavel-snyk/terraform-provider-snyk/internal/validator"
+)
+
+var (
+	_ datasource.DataSource              = (*projectDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*projectDataSource)(nil)
+)
+
+// projectDataSource is the project datasource implementation.
+type projectDataSource struct {
+	client       *snyk.Client
+	defaultOrgID string
+}
+
+// projectDataSourceModel maps the project datasource schema data.
+type projectDataSourceModel struct {
+	CreatedAt       types.String `tfsdk:"created_at"`
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	OrgID           types.String `tfsdk:"organization_id"`
+	Origin          types.String `tfsdk:"origin"`
+	Status          types.String `tfsdk:"status"`
+	TargetFile      types.String `tfsdk:"target_file"`
+	TargetReference types.String `tfsdk:"target_reference"`
+	Type            types.String `tfsdk:"type"`
+}
+
+func NewProjectDataSource() datasource.DataSource {
+	return &projectDataSource{}
+}
+
+func (d *projectDataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "snyk_project"
+}
+
+func (d *projectDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: `
+The project data source provides information about an existing Snyk project.
+
+A Project in Snyk is a scannable item, e.g. a manifest file, container image or IaC file,
+imported from a target into an organization. The project is looked up within the organization
+by any combination of ID, name and target reference. Exactly one project must match.
+See [Snyk Projects](https://docs.snyk.io/snyk-platform-administration/snyk-projects).
+`,
+		Attributes: map[string]schema.Attribute{
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "The date the project was created at, in RFC 3339 format.",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the project.",
+				Computed:            true,
+				Optional:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the project.",
+				Computed:            true,
+				Optional:            true,
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the organization to which the project belongs. Defaults to the `default_organization_id` of the provider.",
+				Computed:            true,
+				Optional:            true,
+				Validators: []validator.String{
+					snykvalidator.NotEmptyString(),
+				},
+			},
+			"origin": schema.StringAttribute{
+				MarkdownDescription: "The origin the project was added from, e.g. `github` or `cli`.",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the project, either `active` or `inactive`.",
+				Computed:            true,
+			},
+			"target_file": schema.StringAttribute{
+				MarkdownDescription: "The path within the target identifying the scanned file, directory or image.",
+				Computed:            true,
+			},
+			"target_reference": schema.StringAttribute{
+				MarkdownDescription: "The revision of the target that is scanned, e.g. a branch name or image tag.",
+				Computed:            true,
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The package manager or scan type of the project, e.g. `npm` or `dockerfile`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *projectDataSource) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := request.ProviderData.(*snykProviderData)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unconfigured Snyk client",
+			"Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	d.client = providerData.client
+	d.defaultOrgID = providerData.defaultOrgID
+}
+
+func (d *projectDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data projectDataSourceModel
+
+	diags := request.Config.Get(ctx, &data)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	orgID := data.OrgID.ValueString()
+	if data.OrgID.IsNull() {
+		orgID = d.defaultOrgID
+	}
+	if orgID == "" {
+		response.Diagnostics.AddError(
+			"Missing required attributes",
+			`The attribute "organization_id" or the provider attribute "default_organization_id" must be defined.`,
+		)
+		return
+	}
+	projectID := data.ID.ValueString()
+	projectName := data.Name.ValueString()
+	targetReference := data.TargetReference.ValueString()
+	if projectID == "" && projectName == "" && targetReference == "" {
+		response.Diagnostics.AddError(
+			"Missing required attributes",
+			`The attribute "id", "name" or "target_reference" must be defined.`,
+		)
+		return
+	}
+
+	var project *snyk.Project
+	if projectID != "" {
+		tflog.Debug(ctx, "Getting project by id", map[string]any{"organization_id": orgID, "project_id": projectID})
+		p, resp, err := d.client.Projects.Get(ctx, orgID, projectID)
+		if err != nil {
+			if resp != nil && resp.StatusCode == http.StatusNotFound {
+				response.Diagnostics.AddError("No search results", "Please refine your search.")
+				return
+			}
+			response.Diagnostics.AddError("Unable to get project", err.Error())
+			return
+		}
+		tflog.Debug(ctx, "Got project", map[string]any{"data": p})
+
+		// if name or target reference are defined check that they are equal
+		if projectName != "" && projectName != p.Attributes.Name {
+			response.Diagnostics.AddError(
+				"Ambiguous search results",
+				fmt.Sprintf("Specified and actual project name are different: expected '%s', got '%s'", projectName, p.Attributes.Name),
+			)
+			return
+		}
+		if targetReference != "" && targetReference != p.Attributes.TargetReference {
+			response.Diagnostics.AddError(
+				"Ambiguous search results",
+				fmt.Sprintf("Specified and actual project target reference are different: expected '%s', got '%s'", targetReference, p.Attributes.TargetReference),
+			)
+			return
+		}
+		project = p
+	} else {
+		tflog.Info(ctx, "Searching for project", map[string]any{
+			"organization_id":  orgID,
+			"project_name":     projectName,
+			"target_reference": targetReference,
+		})
+		projects, err := listAllProjects(ctx, d.client, orgID)
+		if err != nil {
+			response.Diagnostics.AddError("Unable to list projects", err.Error())
+			return
+		}
+
+		var matches []snyk.Project
+		for _, p := range projects {
+			if projectName != "" && projectName != p.Attributes.Name {
+				continue
+			}
+			if targetReference != "" && targetReference != p.Attributes.TargetReference {
+				continue
+			}
+			matches = append(matches, p)
+		}
+		switch len(matches) {
+		case 0:
+			response.Diagnostics.AddError("No search results", "Please refine your search.")
+			return
+		case 1:
+			tflog.Info(ctx, "Found project", map[string]any{"data": matches[0]})
+			project = &matches[0]
+		default:
+			matchedIDs := make([]string, 0, len(matches))
+			for _, m := range matches {
+				matchedIDs = append(matchedIDs, m.ID)
+			}
+			response.Diagnostics.AddError(
+				"Ambiguous search results",
+				fmt.Sprintf("Found %d projects matching the search criteria (%s). Please refine your search with \"id\", \"name\" or \"target_reference\".",
+					len(matches), strings.Join(matchedIDs, ", ")),
+			)
+			return
+		}
+	}
+
+	// map response body to attributes
+	data.CreatedAt = types.StringValue(project.Attributes.CreatedAt.Format(time.RFC3339))
+	data.ID = types.StringValue(project.ID)
+	data.Name = types.StringValue(project.Attributes.Name)
+	data.OrgID = types.StringValue(orgID)
+	data.Origin = types.StringValue(project.Attributes.Origin)
+	data.Status = types.StringValue(project.Attributes.Status)
+	data.TargetFile = types.StringValue(project.Attributes.TargetFile)
+	data.TargetReference = types.StringValue(project.Attributes.TargetReference)
+	data.Type = types.StringValue(project.Attributes.Type)
+
+	diags = response.State.Set(ctx, &data)
+	response.Diagnostics.Append(diags...)
+}
+
+// listAllProjects returns all projects of the organization by following the pagination links.
+func listAllProjects(ctx context.Context, client *snyk.Client, orgID string) ([]snyk.Project, error) {
+	return listAllPages(ctx, func(ctx context.Context, opts snyk.ListOptions) ([]snyk.Project, *snyk.Response, error) {
+		return client.Projects.List(ctx, orgID, &snyk.ListProjectsOptions{ListOptions: opts})
+	})
+}
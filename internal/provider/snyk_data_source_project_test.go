@@ -0,0 +1,293 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	fwdatasource "github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccSnykProjectDataSource_expectError(t *testing.T) {
+	t.Parallel()
+
+	orgName := acctest.RandomWithPrefix(accTestPrefix)
+	groupID := accTestGroupID()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSnykProjectDataSourceConfigWithoutFilters,
+				ExpectError: regexp.MustCompile(`The attribute "id", "name" or "target_reference" must be defined`),
+			},
+			{
+				Config:      testAccSnykProjectDataSourceConfigWithName(orgName, groupID),
+				ExpectError: regexp.MustCompile(`No search results`),
+			},
+		},
+	})
+}
+
+const testProjectsResponse = `
+{
+  "data": [
+    {
+      "id": "project-main",
+      "type": "project",
+      "attributes": {
+        "created": "2025-01-02T03:04:05Z",
+        "name": "frontend/next-gen-ui:package.json",
+        "origin": "github",
+        "status": "active",
+        "target_file": "package.json",
+        "target_reference": "main",
+        "type": "npm"
+      }
+    },
+    {
+      "id": "project-develop",
+      "type": "project",
+      "attributes": {
+        "created": "2025-01-02T03:04:05Z",
+        "name": "frontend/next-gen-ui:package.json",
+        "origin": "github",
+        "status": "inactive",
+        "target_file": "package.json",
+        "target_reference": "develop",
+        "type": "npm"
+      }
+    }
+  ]
+}`
+
+func TestProjectDataSource_Read(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		config            map[string]string
+		expectedID        string
+		expectedErrorText string
+	}{
+		"by-name-and-target-reference": {
+			config:     map[string]string{"organization_id": "org-id", "name": "frontend/next-gen-ui:package.json", "target_reference": "develop"},
+			expectedID: "project-develop",
+		},
+		"by-target-reference": {
+			config:     map[string]string{"organization_id": "org-id", "target_reference": "main"},
+			expectedID: "project-main",
+		},
+		"by-id": {
+			config:     map[string]string{"organization_id": "org-id", "id": "project-main"},
+			expectedID: "project-main",
+		},
+		"by-id-with-different-target-reference": {
+			config:            map[string]string{"organization_id": "org-id", "id": "project-main", "target_reference": "develop"},
+			expectedErrorText: "Ambiguous search results",
+		},
+		"by-unknown-id": {
+			config:            map[string]string{"organization_id": "org-id", "id": "unknown"},
+			expectedErrorText: "No search results",
+		},
+		"multiple-matches": {
+			config:            map[string]string{"organization_id": "org-id", "name": "frontend/next-gen-ui:package.json"},
+			expectedErrorText: "Ambiguous search results",
+		},
+		"no-matches": {
+			config:            map[string]string{"organization_id": "org-id", "name": "backend:pom.xml"},
+			expectedErrorText: "No search results",
+		},
+		"without-filters": {
+			config:            map[string]string{"organization_id": "org-id"},
+			expectedErrorText: "Missing required attributes",
+		},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/orgs/org-id/projects", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = fmt.Fprint(w, testProjectsResponse)
+	})
+	mux.HandleFunc("/rest/orgs/org-id/projects/project-main", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = fmt.Fprint(w, `
+{
+  "data": {
+    "id": "project-main",
+    "type": "project",
+    "attributes": {
+      "created": "2025-01-02T03:04:05Z",
+      "name": "frontend/next-gen-ui:package.json",
+      "origin": "github",
+      "status": "active",
+      "target_file": "package.json",
+      "target_reference": "main",
+      "type": "npm"
+    }
+  }
+}`)
+	})
+	mux.HandleFunc("/rest/orgs/org-id/projects/unknown", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	d := &projectDataSource{client: newTestClient(t, mux)}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			config := testDataSourceConfig(t, d, test.config)
+
+			response := &fwdatasource.ReadResponse{State: tfsdk.State{Schema: config.Schema, Raw: config.Raw.Copy()}}
+			d.Read(ctx, fwdatasource.ReadRequest{Config: config}, response)
+
+			if test.expectedErrorText != "" {
+				require.True(t, response.Diagnostics.HasError())
+				assert.Equal(t, test.expectedErrorText, response.Diagnostics[0].Summary())
+				return
+			}
+			require.False(t, response.Diagnostics.HasError(), "unexpected diagnostics: %v", response.Diagnostics)
+
+			var data projectDataSourceModel
+			response.Diagnostics.Append(response.State.Get(ctx, &data)...)
+			require.False(t, response.Diagnostics.HasError(), "unexpected diagnostics: %v", response.Diagnostics)
+			assert.Equal(t, test.expectedID, data.ID.ValueString())
+			assert.Equal(t, "2025-01-02T03:04:05Z", data.CreatedAt.ValueString())
+			assert.Equal(t, "github", data.Origin.ValueString())
+			assert.Equal(t, "package.json", data.TargetFile.ValueString())
+			assert.Equal(t, "npm", data.Type.ValueString())
+		})
+	}
+}
+
+func TestProjectDataSource_ReadUsesProviderDefaultOrganizationID(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		defaultOrgID      string
+		expectedErrorText string
+	}{
+		"provider-default": {
+			defaultOrgID: "org-id",
+		},
+		"missing-default": {
+			expectedErrorText: `The attribute "organization_id" or the provider attribute "default_organization_id" must be defined.`,
+		},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/orgs/org-id/projects", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = fmt.Fprint(w, testProjectsResponse)
+	})
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			d := &projectDataSource{client: newTestClient(t, mux), defaultOrgID: test.defaultOrgID}
+			config := testDataSourceConfig(t, d, map[string]string{"target_reference": "main"})
+
+			response := &fwdatasource.ReadResponse{State: tfsdk.State{Schema: config.Schema, Raw: config.Raw.Copy()}}
+			d.Read(ctx, fwdatasource.ReadRequest{Config: config}, response)
+
+			if test.expectedErrorText != "" {
+				require.True(t, response.Diagnostics.HasError())
+				assert.Equal(t, test.expectedErrorText, response.Diagnostics[0].Detail())
+				return
+			}
+			require.False(t, response.Diagnostics.HasError(), "unexpected diagnostics: %v", response.Diagnostics)
+
+			var data projectDataSourceModel
+			response.Diagnostics.Append(response.State.Get(ctx, &data)...)
+			require.False(t, response.Diagnostics.HasError(), "unexpected diagnostics: %v", response.Diagnostics)
+			assert.Equal(t, "org-id", data.OrgID.ValueString())
+			assert.Equal(t, "project-main", data.ID.ValueString())
+		})
+	}
+}
+
+func TestProjectDataSource_ValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		organizationID    types.String
+		expectedErrorText string
+	}{
+		"organization-id": {
+			organizationID: types.StringValue("org-id"),
+		},
+		"without-organization-id": {
+			organizationID: types.StringNull(),
+		},
+		"empty-organization-id": {
+			organizationID:    types.StringValue(""),
+			expectedErrorText: "string must not be empty",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			config := testDataSourceConfig(t, &projectDataSource{}, map[string]string{"target_reference": "main"})
+			state := tfsdk.State{Schema: config.Schema, Raw: config.Raw}
+			require.False(t, state.SetAttribute(ctx, path.Root("organization_id"), test.organizationID).HasError())
+			config.Raw = state.Raw
+
+			diagnostics := testValidateDataSourceConfig(t, "snyk_project", config)
+
+			if test.expectedErrorText != "" {
+				require.Len(t, diagnostics, 1)
+				assert.Equal(t, test.expectedErrorText, diagnostics[0].Summary)
+				return
+			}
+			assert.Empty(t, diagnostics)
+		})
+	}
+}
+
+func TestListAllProjects_followsPagination(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/orgs/org-id/projects", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("starting_after") == "" {
+			_, _ = fmt.Fprint(w, `{"data": [{"id": "project-1", "type": "project", "attributes": {}}], "links": {"next": "/orgs/org-id/projects?starting_after=cursor-1&version=2025-11-05"}}`)
+			return
+		}
+		_, _ = fmt.Fprint(w, `{"data": [{"id": "project-2", "type": "project", "attributes": {}}], "links": {}}`)
+	})
+
+	projects, err := listAllProjects(context.Background(), newTestClient(t, mux), "org-id")
+
+	require.NoError(t, err)
+	require.Len(t, projects, 2)
+	assert.Equal(t, "project-1", projects[0].ID)
+	assert.Equal(t, "project-2", projects[1].ID)
+}
+
+func testAccSnykProjectDataSourceConfigWithName(orgName, groupID string) string {
+	return fmt.Sprintf(`
+data "snyk_project" "test" {
+  organization_id = snyk_organization.test.id
+
+  name = "does-not-exist"
+}
+
+resource "snyk_organization" "test" {
+  name     = %[1]q
+  group_id = %[2]q
+}
+`, orgName, groupID)
+}
+
+const testAccSnykProjectDataSourceConfigWithoutFilters = `
+data "snyk_project" "test" {
+  organization_id = "00000000-0000-0000-0000-000000000000"
+}
+`
@@ -0,0 +1,245 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/pavel-snyk/snyk-sdk-go/v2/snyk"
+
+	snykvalidator "github.com/pavel-snyk/terraform-provider-snyk/internal/validator"
+)
+
+var (
+	_ datasource.DataSource              = (*projectsDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*projectsDataSource)(nil)
+)
+
+// projectsDataSource is the projects datasource implementation.
+type projectsDataSource struct {
+	client       *snyk.Client
+	defaultOrgID string
+}
+
+// projectsDataSourceModel maps the projects datasource schema data.
+type projectsDataSourceModel struct {
+	NameRegex       types.String                     `tfsdk:"name_regex"`
+	OrgID           types.String                     `tfsdk:"organization_id"`
+	Origin          types.String                     `tfsdk:"origin"`
+	Projects        []projectsDataSourceProjectModel `tfsdk:"projects"`
+	Status          types.String                     `tfsdk:"status"`
+	TargetReference types.String                     `tfsdk:"target_reference"`
+	Type            types.String                     `tfsdk:"type"`
+}
+
+// projectsDataSourceProjectModel maps a project of the projects datasource schema data.
+type projectsDataSourceProjectModel struct {
+	CreatedAt       types.String `tfsdk:"created_at"`
+	ID              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Origin          types.String `tfsdk:"origin"`
+	Status          types.String `tfsdk:"status"`
+	TargetFile      types.String `tfsdk:"target_file"`
+	TargetReference types.String `tfsdk:"target_reference"`
+	Type            types.String `tfsdk:"type"`
+}
+
+func NewProjectsDataSource() datasource.DataSource {
+	return &projectsDataSource{}
+}
+
+func (d *projectsDataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "snyk_projects"
+}
+
+func (d *projectsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: `
+The projects data source provides information about all Snyk projects of an organization matching the given filters.
+
+A Project in Snyk is a scannable item, e.g. a manifest file, container image or IaC file,
+imported from a target into an organization.
+See [Snyk Projects](https://docs.snyk.io/snyk-platform-administration/snyk-projects).
+`,
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "The regular expression matching the project names, e.g. `^frontend/.*:package\\.json$`. " +
+					"See the [syntax](https://pkg.go.dev/regexp/syntax) of regular expressions.",
+				Optional: true,
+			},
+			"organization_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the organization to which the projects belong. Defaults to the `default_organization_id` of the provider.",
+				Computed:            true,
+				Optional:            true,
+				Validators: []validator.String{
+					snykvalidator.NotEmptyString(),
+				},
+			},
+			"origin": schema.StringAttribute{
+				MarkdownDescription: "The origin the projects were added from, e.g. `github` or `cli`.",
+				Optional:            true,
+			},
+			"projects": schema.ListNestedAttribute{
+				MarkdownDescription: "The projects matching all filters.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "The date the project was created at, in RFC 3339 format.",
+							Computed:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the project.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the project.",
+							Computed:            true,
+						},
+						"origin": schema.StringAttribute{
+							MarkdownDescription: "The origin the project was added from, e.g. `github` or `cli`.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The status of the project, either `active` or `inactive`.",
+							Computed:            true,
+						},
+						"target_file": schema.StringAttribute{
+							MarkdownDescription: "The path within the target identifying the scanned file, directory or image.",
+							Computed:            true,
+						},
+						"target_reference": schema.StringAttribute{
+							MarkdownDescription: "The revision of the target that is scanned, e.g. a branch name or image tag.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The package manager or scan type of the project, e.g. `npm` or `dockerfile`.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The status of the projects, either `active` or `inactive`.",
+				Optional:            true,
+			},
+			"target_reference": schema.StringAttribute{
+				MarkdownDescription: "The revision of the target that is scanned, e.g. a branch name or image tag.",
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The package manager or scan type of the projects, e.g. `npm` or `dockerfile`.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (d *projectsDataSource) Configure(_ context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if request.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := request.ProviderData.(*snykProviderData)
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unconfigured Snyk client",
+			"Please report this issue to the provider developers.",
+		)
+		return
+	}
+
+	d.client = providerData.client
+	d.defaultOrgID = providerData.defaultOrgID
+}
+
+func (d *projectsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data projectsDataSourceModel
+
+	diags := request.Config.Get(ctx, &data)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	orgID := data.OrgID.ValueString()
+	if data.OrgID.IsNull() {
+		orgID = d.defaultOrgID
+	}
+	if orgID == "" {
+		response.Diagnostics.AddError(
+			"Missing required attributes",
+			`The attribute "organization_id" or the provider attribute "default_organization_id" must be defined.`,
+		)
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			response.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid regular expression",
+				fmt.Sprintf("Unable to parse the name regex %q: %s", data.NameRegex.ValueString(), err),
+			)
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "Getting all projects", map[string]any{"organization_id": orgID})
+	projects, err := listAllProjects(ctx, d.client, orgID)
+	if err != nil {
+		response.Diagnostics.AddError("Unable to list projects", err.Error())
+		return
+	}
+
+	data.Projects = []projectsDataSourceProjectModel{}
+	for _, project := range projects {
+		if project.Attributes == nil {
+			tflog.Debug(ctx, "Skipping project without attributes", map[string]any{"project_id": project.ID})
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(project.Attributes.Name) {
+			continue
+		}
+		if !data.Origin.IsNull() && project.Attributes.Origin != data.Origin.ValueString() {
+			continue
+		}
+		if !data.Status.IsNull() && project.Attributes.Status != data.Status.ValueString() {
+			continue
+		}
+		if !data.TargetReference.IsNull() && project.Attributes.TargetReference != data.TargetReference.ValueString() {
+			continue
+		}
+		if !data.Type.IsNull() && project.Attributes.Type != data.Type.ValueString() {
+			continue
+		}
+
+		// map response body to attributes
+		data.Projects = append(data.Projects, projectsDataSourceProjectModel{
+			CreatedAt:       types.StringValue(project.Attributes.CreatedAt.Format(time.RFC3339)),
+			ID:              types.StringValue(project.ID),
+			Name:            types.StringValue(project.Attributes.Name),
+			Origin:          types.StringValue(project.Attributes.Origin),
+			Status:          types.StringValue(project.Attributes.Status),
+			TargetFile:      types.StringValue(project.Attributes.TargetFile),
+			TargetReference: types.StringValue(project.Attributes.TargetReference),
+			Type:            types.StringValue(project.Attributes.Type),
+		})
+	}
+	tflog.Info(ctx, "Found projects", map[string]any{"organization_id": orgID, "count": len(data.Projects)})
+	data.OrgID = types.StringValue(orgID)
+
+	diags = response.State.Set(ctx, &data)
+	response.Diagnostics.Append(diags...)
+}
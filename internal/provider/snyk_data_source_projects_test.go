@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	fwdatasource "github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProjectsDataSource_Read(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		config            map[string]string
+		defaultOrgID      string
+		expectedIDs       []string
+		expectedErrorText string
+	}{
+		"without-filters": {
+			config:      map[string]string{"organization_id": "org-id"},
+			expectedIDs: []string{"project-main", "project-develop"},
+		},
+		"by-name-regex": {
+			config:      map[string]string{"organization_id": "org-id", "name_regex": "^frontend/.*:package\\.json$"},
+			expectedIDs: []string{"project-main", "project-develop"},
+		},
+		"by-origin-and-type": {
+			config:      map[string]string{"organization_id": "org-id", "origin": "github", "type": "npm"},
+			expectedIDs: []string{"project-main", "project-develop"},
+		},
+		"by-status": {
+			config:      map[string]string{"organization_id": "org-id", "status": "inactive"},
+			expectedIDs: []string{"project-develop"},
+		},
+		"by-target-reference": {
+			config:      map[string]string{"organization_id": "org-id", "target_reference": "main"},
+			expectedIDs: []string{"project-main"},
+		},
+		"by-provider-default-organization": {
+			config:       map[string]string{},
+			defaultOrgID: "org-id",
+			expectedIDs:  []string{"project-main", "project-develop"},
+		},
+		"no-matches": {
+			config:      map[string]string{"organization_id": "org-id", "origin": "cli"},
+			expectedIDs: []string{},
+		},
+		"invalid-name-regex": {
+			config:            map[string]string{"organization_id": "org-id", "name_regex": "frontend/("},
+			expectedErrorText: "Invalid regular expression",
+		},
+		"without-organization": {
+			config:            map[string]string{},
+			expectedErrorText: "Missing required attributes",
+		},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/orgs/org-id/projects", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = fmt.Fprint(w, testProjectsResponse)
+	})
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			d := &projectsDataSource{client: newTestClient(t, mux), defaultOrgID: test.defaultOrgID}
+			config := testDataSourceConfig(t, d, test.config)
+
+			response := &fwdatasource.ReadResponse{State: tfsdk.State{Schema: config.Schema, Raw: config.Raw.Copy()}}
+			d.Read(ctx, fwdatasource.ReadRequest{Config: config}, response)
+
+			if test.expectedErrorText != "" {
+				require.True(t, response.Diagnostics.HasError())
+				assert.Equal(t, test.expectedErrorText, response.Diagnostics[0].Summary())
+				return
+			}
+			require.False(t, response.Diagnostics.HasError(), "unexpected diagnostics: %v", response.Diagnostics)
+
+			var data projectsDataSourceModel
+			response.Diagnostics.Append(response.State.Get(ctx, &data)...)
+			require.False(t, response.Diagnostics.HasError(), "unexpected diagnostics: %v", response.Diagnostics)
+			assert.Equal(t, "org-id", data.OrgID.ValueString())
+			actualIDs := make([]string, 0, len(data.Projects))
+			for _, project := range data.Projects {
+				actualIDs = append(actualIDs, project.ID.ValueString())
+				assert.Equal(t, "2025-01-02T03:04:05Z", project.CreatedAt.ValueString())
+				assert.Equal(t, "package.json", project.TargetFile.ValueString())
+			}
+			assert.Equal(t, test.expectedIDs, actualIDs)
+		})
+	}
+}
+
+func TestProjectsDataSource_ReadSkipsProjectsWithoutAttributes(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/orgs/org-id/projects", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = fmt.Fprint(w, `{"data": [{"id": "project-main", "type": "project", "attributes": {"name": "frontend"}}, {"id": "project-broken", "type": "project"}]}`)
+	})
+	ctx := context.Background()
+	d := &projectsDataSource{client: newTestClient(t, mux)}
+	config := testDataSourceConfig(t, d, map[string]string{"organization_id": "org-id", "name_regex": "^front"})
+
+	response := &fwdatasource.ReadResponse{State: tfsdk.State{Schema: config.Schema, Raw: config.Raw.Copy()}}
+	d.Read(ctx, fwdatasource.ReadRequest{Config: config}, response)
+	require.False(t, response.Diagnostics.HasError(), "unexpected diagnostics: %v", response.Diagnostics)
+
+	var data projectsDataSourceModel
+	response.Diagnostics.Append(response.State.Get(ctx, &data)...)
+	require.False(t, response.Diagnostics.HasError(), "unexpected diagnostics: %v", response.Diagnostics)
+	require.Len(t, data.Projects, 1)
+	assert.Equal(t, "project-main", data.Projects[0].ID.ValueString())
+}
+
+func TestProjectsDataSource_ValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		organizationID    types.String
+		expectedErrorText string
+	}{
+		"organization-id": {
+			organizationID: types.StringValue("org-id"),
+		},
+		"without-organization-id": {
+			organizationID: types.StringNull(),
+		},
+		"empty-organization-id": {
+			organizationID:    types.StringValue(""),
+			expectedErrorText: "string must not be empty",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			config := testDataSourceConfig(t, &projectsDataSource{}, map[string]string{})
+			state := tfsdk.State{Schema: config.Schema, Raw: config.Raw}
+			require.False(t, state.SetAttribute(ctx, path.Root("organization_id"), test.organizationID).HasError())
+			config.Raw = state.Raw
+
+			diagnostics := testValidateDataSourceConfig(t, "snyk_projects", config)
+
+			if test.expectedErrorText != "" {
+				require.Len(t, diagnostics, 1)
+				assert.Equal(t, test.expectedErrorText, diagnostics[0].Summary)
+				return
+			}
+			assert.Empty(t, diagnostics)
+		})
+	}
+}
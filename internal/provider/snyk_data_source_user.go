@@ -70,7 +70,7 @@ func (d *userDataSource) Configure(_ context.Context, request datasource.Configu
 		return
 	}
 
-	client, ok := request.ProviderData.(*snyk.Client)
+	providerData, ok := request.ProviderData.(*snykProviderData)
 	if !ok {
 		response.Diagnostics.AddError(
 			"Unconfigured Snyk client",
@@ -79,7 +79,7 @@ func (d *userDataSource) Configure(_ context.Context, request datasource.Configu
 		return
 	}
 
-	d.client = client
+	d.client = providerData.client
 }
 
 func (d *userDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
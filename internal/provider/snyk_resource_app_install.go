@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -11,27 +12,35 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/pavel-snyk/snyk-sdk-go/v2/snyk"
+
+	"github.com/pavel-snyk/terraform-provider-snyk/internal/provider/helper"
 )
 
 var (
 	_ resource.Resource                = (*appInstallResource)(nil)
 	_ resource.ResourceWithConfigure   = (*appInstallResource)(nil)
 	_ resource.ResourceWithImportState = (*appInstallResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*appInstallResource)(nil)
 )
 
 // appInstallResource defines the app installation resource implementation.
 type appInstallResource struct {
-	client *snyk.Client
+	appInstallOrgs *helper.AppInstallOrgResolver
+	client         *snyk.Client
+
+	// provider defaults for unset attributes
+	defaultOrgID string
 }
 
 // appInstallResourceModel describes the app installation resource data model.
 type appInstallResourceModel struct {
-	AppID        types.String `tfsdk:"app_id"`
-	AppName      types.String `tfsdk:"app_name"`
-	ClientID     types.String `tfsdk:"client_id"`
-	ClientSecret types.String `tfsdk:"client_secret"`
-	ID           types.String `tfsdk:"id"`
-	OrgID        types.String `tfsdk:"organization_id"`
+	AppID        types.String   `tfsdk:"app_id"`
+	AppName      types.String   `tfsdk:"app_name"`
+	ClientID     types.String   `tfsdk:"client_id"`
+	ClientSecret types.String   `tfsdk:"client_secret"`
+	ID           types.String   `tfsdk:"id"`
+	OrgID        types.String   `tfsdk:"organization_id"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
 }
 
 func NewAppInstallResource() resource.Resource {
@@ -42,7 +51,7 @@ func (r *appInstallResource) Metadata(_ context.Context, _ resource.MetadataRequ
 	response.TypeName = "snyk_app_install"
 }
 
-func (r *appInstallResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+func (r *appInstallResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
 	response.Schema = schema.Schema{
 		MarkdownDescription: `
 The app install resource allows to manage Snyk app installations.
@@ -90,13 +99,18 @@ powered by OAuth 2.0 for a developer-friendly experience. See [Snyk Apps](https:
 				},
 			},
 			"organization_id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the organization where the app will be installed.",
-				Required:            true,
+				MarkdownDescription: "The ID of the organization where the app will be installed. Defaults to the `default_organization_id` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Delete: true}),
+		},
 	}
 }
 
@@ -105,7 +119,7 @@ func (r *appInstallResource) Configure(_ context.Context, request resource.Confi
 		return
 	}
 
-	client, ok := request.ProviderData.(*snyk.Client)
+	providerData, ok := request.ProviderData.(*snykProviderData)
 	if !ok {
 		response.Diagnostics.AddError(
 			"Unconfigured Snyk client",
@@ -114,7 +128,13 @@ func (r *appInstallResource) Configure(_ context.Context, request resource.Confi
 		return
 	}
 
-	r.client = client
+	r.appInstallOrgs = providerData.appInstallOrgs
+	r.client = providerData.client
+	r.defaultOrgID = providerData.defaultOrgID
+}
+
+func (r *appInstallResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	helper.PlanProviderDefault(ctx, request, response, "organization_id", r.defaultOrgID, "default_organization_id", true)
 }
 
 func (r *appInstallResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
@@ -126,6 +146,14 @@ func (r *appInstallResource) Create(ctx context.Context, request resource.Create
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	appID := data.AppID.ValueString()
 	orgID := data.OrgID.ValueString()
 	tflog.Trace(ctx, "Creating app install for organization", map[string]any{"app_id": appID, "org_id": orgID})
@@ -172,6 +200,9 @@ func (r *appInstallResource) Create(ctx context.Context, request resource.Create
 	data.ID = types.StringValue(appInstall.ID)
 	data.OrgID = types.StringValue(orgID)
 
+	// remember the organization, so broker deployments don't have to search for it
+	r.appInstallOrgs.Store(appInstall.ID, orgID)
+
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
@@ -184,6 +215,14 @@ func (r *appInstallResource) Read(ctx context.Context, request resource.ReadRequ
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	appInstallID := data.ID.ValueString()
 	orgID := data.OrgID.ValueString()
 	tflog.Trace(ctx, "Getting app install", map[string]any{"app_install_id": appInstallID, "org_id": orgID})
@@ -219,12 +258,24 @@ func (r *appInstallResource) Read(ctx context.Context, request resource.ReadRequ
 	data.ID = types.StringValue(appInstall.ID)
 	data.OrgID = types.StringValue(orgID)
 
+	// remember the organization, so broker deployments don't have to search for it
+	r.appInstallOrgs.Store(appInstall.ID, orgID)
+
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
-func (r *appInstallResource) Update(context.Context, resource.UpdateRequest, *resource.UpdateResponse) {
+func (r *appInstallResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
 	// app_id and organization_id are mandatory and RequiresReplace()
-	// no update available
+	// only timeouts can be updated, they are not sent to Snyk
+	var data appInstallResourceModel
+
+	// read plan data into the model
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
 
 func (r *appInstallResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
@@ -236,6 +287,14 @@ func (r *appInstallResource) Delete(ctx context.Context, request resource.Delete
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	appInstallID := data.ID.ValueString()
 	orgID := data.OrgID.ValueString()
 	tflog.Trace(ctx, "Deleting app install for org", map[string]any{"app_install_id": appInstallID, "org_id": orgID})
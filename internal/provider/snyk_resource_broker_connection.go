@@ -2,9 +2,13 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"strings"
 
-	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -19,38 +23,64 @@ import (
 )
 
 var (
-	_ resource.Resource              = (*brokerConnectionResource)(nil)
-	_ resource.ResourceWithConfigure = (*brokerConnectionResource)(nil)
+	_ resource.Resource                = (*brokerConnectionResource)(nil)
+	_ resource.ResourceWithConfigure   = (*brokerConnectionResource)(nil)
+	_ resource.ResourceWithImportState = (*brokerConnectionResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*brokerConnectionResource)(nil)
 )
 
 // brokerConnectionResource defines the broker connection resource implementation.
 type brokerConnectionResource struct {
 	client *snyk.Client
+
+	// provider defaults for unset attributes
+	defaultTenantID string
 }
 
 // brokerConnectionResourceModel describes the broker connection resource data model.
 type brokerConnectionResourceModel struct {
-	AppInstallID       types.String `tfsdk:"app_install_id"`
-	BrokerDeploymentID types.String `tfsdk:"broker_deployment_id"`
-	Configuration      types.Object `tfsdk:"configuration"` // brokerConnectionConfigurationModel
-	ID                 types.String `tfsdk:"id"`
-	Name               types.String `tfsdk:"name"`
-	TenantID           types.String `tfsdk:"tenant_id"`
-	Type               types.String `tfsdk:"type"`
+	AppInstallID       types.String   `tfsdk:"app_install_id"`
+	BrokerDeploymentID types.String   `tfsdk:"broker_deployment_id"`
+	Configuration      types.Object   `tfsdk:"configuration"` // brokerConnectionConfigurationModel
+	ID                 types.String   `tfsdk:"id"`
+	Name               types.String   `tfsdk:"name"`
+	TenantID           types.String   `tfsdk:"tenant_id"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+	Type               types.String   `tfsdk:"type"`
 }
 
 type brokerConnectionResourceConfigurationModel struct {
+	ArtifactoryURL                types.String `tfsdk:"artifactory_url"`
+	AzureReposHostname            types.String `tfsdk:"azure_repos_hostname"`
+	AzureReposOrganization        types.String `tfsdk:"azure_repos_organization"`
+	AzureReposTokenCredentialID   types.String `tfsdk:"azure_repos_token_credential_id"`
 	BitbucketHostname             types.String `tfsdk:"bitbucket_hostname"`
 	BitbucketPasswordCredentialID types.String `tfsdk:"bitbucket_password_credential_id"`
 	BitbucketPATCredentialID      types.String `tfsdk:"bitbucket_pat_credential_id"`
 	BitbucketUsername             types.String `tfsdk:"bitbucket_username"`
 	BrokerClientURL               types.String `tfsdk:"broker_client_url"`
+	CRAgentURL                    types.String `tfsdk:"cr_agent_url"`
+	CRBase                        types.String `tfsdk:"cr_base"`
+	CRExternalID                  types.String `tfsdk:"cr_external_id"`
+	CRPasswordCredentialID        types.String `tfsdk:"cr_password_credential_id"`
+	CRRegion                      types.String `tfsdk:"cr_region"`
+	CRRoleARN                     types.String `tfsdk:"cr_role_arn"`
+	CRTokenCredentialID           types.String `tfsdk:"cr_token_credential_id"`
+	CRUsername                    types.String `tfsdk:"cr_username"`
+	GitHubAPIHostname             types.String `tfsdk:"github_api_hostname"`
+	GitHubAppClientID             types.String `tfsdk:"github_app_client_id"`
+	GitHubAppID                   types.String `tfsdk:"github_app_id"`
+	GitHubAppInstallationID       types.String `tfsdk:"github_app_installation_id"`
+	GitHubAppPrivatePEMPath       types.String `tfsdk:"github_app_private_pem_path"`
+	GitHubHostname                types.String `tfsdk:"github_hostname"`
+	GitHubTokenCredentialID       types.String `tfsdk:"github_token_credential_id"`
 	GitLabHostname                types.String `tfsdk:"gitlab_hostname"`
 	GitLabTokenCredentialID       types.String `tfsdk:"gitlab_token_credential_id"`
 	JiraHostname                  types.String `tfsdk:"jira_hostname"`
 	JiraPasswordCredentialID      types.String `tfsdk:"jira_password_credential_id"`
 	JiraPATCredentialID           types.String `tfsdk:"jira_pat_credential_id"`
 	JiraUsername                  types.String `tfsdk:"jira_username"`
+	NexusBaseURL                  types.String `tfsdk:"nexus_base_url"`
 }
 
 func NewBrokerConnectionResource() resource.Resource {
@@ -61,7 +91,7 @@ func (r *brokerConnectionResource) Metadata(_ context.Context, _ resource.Metada
 	response.TypeName = "snyk_broker_connection"
 }
 
-func (r *brokerConnectionResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+func (r *brokerConnectionResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
 	response.Schema = schema.Schema{
 		MarkdownDescription: `
 The broker connection resource allows to manage Snyk broker connections.
@@ -92,6 +122,34 @@ see [Universal Broker documentation](https://docs.snyk.io/implementation-and-set
 					helper.RequiresValidConfiguration(path.MatchRoot("type")),
 				},
 				Attributes: map[string]schema.Attribute{
+					"artifactory_url": schema.StringAttribute{
+						MarkdownDescription: "The Artifactory URL.",
+						Optional:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"azure_repos_hostname": schema.StringAttribute{
+						MarkdownDescription: "The Azure Repos hostname.",
+						Optional:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"azure_repos_organization": schema.StringAttribute{
+						MarkdownDescription: "The Azure Repos organization.",
+						Optional:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"azure_repos_token_credential_id": schema.StringAttribute{
+						MarkdownDescription: "The ID of the broker deployment credential for Azure Repos token.",
+						Optional:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
 					"bitbucket_hostname": schema.StringAttribute{
 						MarkdownDescription: "The Bitbucket hostname.",
 						Optional:            true,
@@ -127,6 +185,111 @@ see [Universal Broker documentation](https://docs.snyk.io/implementation-and-set
 							stringplanmodifier.UseStateForUnknown(),
 						},
 					},
+					"cr_agent_url": schema.StringAttribute{
+						MarkdownDescription: "The URL of the container registry agent.",
+						Optional:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"cr_base": schema.StringAttribute{
+						MarkdownDescription: "The hostname of the container registry.",
+						Optional:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"cr_external_id": schema.StringAttribute{
+						MarkdownDescription: "The external ID used to assume the AWS role for Elastic Container Registry.",
+						Optional:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"cr_password_credential_id": schema.StringAttribute{
+						MarkdownDescription: "The ID of the broker deployment credential for container registry password.",
+						Optional:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"cr_region": schema.StringAttribute{
+						MarkdownDescription: "The AWS region of Elastic Container Registry.",
+						Optional:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"cr_role_arn": schema.StringAttribute{
+						MarkdownDescription: "The ARN of the AWS role to assume for Elastic Container Registry.",
+						Optional:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"cr_token_credential_id": schema.StringAttribute{
+						MarkdownDescription: "The ID of the broker deployment credential for container registry token.",
+						Optional:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"cr_username": schema.StringAttribute{
+						MarkdownDescription: "The container registry username.",
+						Optional:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"github_api_hostname": schema.StringAttribute{
+						MarkdownDescription: "The GitHub API hostname.",
+						Optional:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"github_app_client_id": schema.StringAttribute{
+						MarkdownDescription: "The client ID of the GitHub App.",
+						Optional:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"github_app_id": schema.StringAttribute{
+						MarkdownDescription: "The ID of the GitHub App.",
+						Optional:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"github_app_installation_id": schema.StringAttribute{
+						MarkdownDescription: "The installation ID of the GitHub App.",
+						Optional:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"github_app_private_pem_path": schema.StringAttribute{
+						MarkdownDescription: "The path to the GitHub App private key (PEM) inside the broker deployment.",
+						Optional:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"github_hostname": schema.StringAttribute{
+						MarkdownDescription: "The GitHub hostname.",
+						Optional:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"github_token_credential_id": schema.StringAttribute{
+						MarkdownDescription: "The ID of the broker deployment credential for GitHub token.",
+						Optional:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
 					"gitlab_hostname": schema.StringAttribute{
 						MarkdownDescription: "The GitLab hostname.",
 						Optional:            true,
@@ -169,6 +332,13 @@ see [Universal Broker documentation](https://docs.snyk.io/implementation-and-set
 							stringplanmodifier.UseStateForUnknown(),
 						},
 					},
+					"nexus_base_url": schema.StringAttribute{
+						MarkdownDescription: "The base URL of Nexus repository manager.",
+						Optional:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
 				},
 			},
 			"id": schema.StringAttribute{
@@ -183,9 +353,11 @@ see [Universal Broker documentation](https://docs.snyk.io/implementation-and-set
 				Required:            true,
 			},
 			"tenant_id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the tenant to which the broker connection belongs.",
-				Required:            true,
+				MarkdownDescription: "The ID of the tenant to which the broker connection belongs. Defaults to the `default_tenant_id` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
@@ -195,12 +367,11 @@ see [Universal Broker documentation](https://docs.snyk.io/implementation-and-set
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
-				//TODO: remove after RequiresValidConfiguration completion
-				Validators: []validator.String{
-					stringvalidator.OneOf("bitbucket-server", "gitlab", "jira"),
-				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
 	}
 }
 
@@ -209,7 +380,7 @@ func (r *brokerConnectionResource) Configure(_ context.Context, request resource
 		return
 	}
 
-	client, ok := request.ProviderData.(*snyk.Client)
+	providerData, ok := request.ProviderData.(*snykProviderData)
 	if !ok {
 		response.Diagnostics.AddError(
 			"Unconfigured Snyk client",
@@ -218,7 +389,12 @@ func (r *brokerConnectionResource) Configure(_ context.Context, request resource
 		return
 	}
 
-	r.client = client
+	r.client = providerData.client
+	r.defaultTenantID = providerData.defaultTenantID
+}
+
+func (r *brokerConnectionResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	helper.PlanProviderDefault(ctx, request, response, "tenant_id", r.defaultTenantID, "default_tenant_id", true)
 }
 
 func (r *brokerConnectionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
@@ -229,6 +405,15 @@ func (r *brokerConnectionResource) Create(ctx context.Context, request resource.
 	if response.Diagnostics.HasError() {
 		return
 	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	var dataConfiguration brokerConnectionResourceConfigurationModel
 	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("configuration"), &dataConfiguration)...)
 	if response.Diagnostics.HasError() {
@@ -239,21 +424,7 @@ func (r *brokerConnectionResource) Create(ctx context.Context, request resource.
 	appInstallID := data.AppInstallID.ValueString()
 	brokerDeploymentID := data.BrokerDeploymentID.ValueString()
 
-	createRequest := &snyk.BrokerConnectionCreateOrUpdateRequest{
-		BitbucketHostname: dataConfiguration.BitbucketHostname.ValueString(),
-		BitbucketPassword: dataConfiguration.BitbucketPasswordCredentialID.ValueString(),
-		BitbucketPAT:      dataConfiguration.BitbucketPATCredentialID.ValueString(),
-		BitbucketUsername: dataConfiguration.BitbucketUsername.ValueString(),
-		BrokerClientURL:   dataConfiguration.BrokerClientURL.ValueString(),
-		GitLabHostname:    dataConfiguration.GitLabHostname.ValueString(),
-		GitLabToken:       dataConfiguration.GitLabTokenCredentialID.ValueString(),
-		JiraHostname:      dataConfiguration.JiraHostname.ValueString(),
-		JiraPassword:      dataConfiguration.JiraPasswordCredentialID.ValueString(),
-		JiraPAT:           dataConfiguration.JiraPATCredentialID.ValueString(),
-		JiraUsername:      dataConfiguration.JiraUsername.ValueString(),
-		Name:              data.Name.ValueString(),
-		Type:              snyk.BrokerConnectionType(data.Type.ValueString()),
-	}
+	createRequest := newBrokerConnectionCreateOrUpdateRequest(data, dataConfiguration)
 	tflog.Trace(ctx, "Creating broker connection", map[string]any{
 		"app_install_id":       appInstallID,
 		"broker_deployment_id": brokerDeploymentID,
@@ -276,11 +447,17 @@ func (r *brokerConnectionResource) Create(ctx context.Context, request resource.
 	// map response body to model
 	data.AppInstallID = types.StringValue(appInstallID)
 	data.BrokerDeploymentID = types.StringValue(brokerConnection.Attributes.BrokerDeploymentID)
-	// configuration todo
+	configuration, diags := flattenBrokerConnectionConfiguration(ctx, brokerConnection.Attributes.Configuration)
+	response.Diagnostics.Append(diags...)
+	configuration, diags = keepPriorBrokerConnectionConfiguration(ctx, data.Configuration, configuration)
+	response.Diagnostics.Append(diags...)
+	data.Configuration = configuration
 	data.ID = types.StringValue(brokerConnection.ID)
 	data.Name = types.StringValue(brokerConnection.Attributes.Name)
 	data.TenantID = types.StringValue(tenantID)
-	data.Type = types.StringValue(string(brokerConnection.Attributes.Configuration.Type))
+	if c := brokerConnection.Attributes.Configuration; c != nil && c.Type != "" {
+		data.Type = types.StringValue(string(c.Type))
+	}
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
@@ -293,11 +470,14 @@ func (r *brokerConnectionResource) Read(ctx context.Context, request resource.Re
 	if response.Diagnostics.HasError() {
 		return
 	}
-	var dataConfiguration brokerConnectionResourceConfigurationModel
-	response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root("configuration"), &dataConfiguration)...)
+
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	response.Diagnostics.Append(diags...)
 	if response.Diagnostics.HasError() {
 		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
 
 	tenantID := data.TenantID.ValueString()
 	appInstallID := data.AppInstallID.ValueString()
@@ -331,11 +511,17 @@ func (r *brokerConnectionResource) Read(ctx context.Context, request resource.Re
 	// map response body to model
 	data.AppInstallID = types.StringValue(appInstallID)
 	data.BrokerDeploymentID = types.StringValue(brokerConnection.Attributes.BrokerDeploymentID)
-	// configuration todo
+	configuration, diags := flattenBrokerConnectionConfiguration(ctx, brokerConnection.Attributes.Configuration)
+	response.Diagnostics.Append(diags...)
+	configuration, diags = keepPriorBrokerConnectionConfiguration(ctx, data.Configuration, configuration)
+	response.Diagnostics.Append(diags...)
+	data.Configuration = configuration
 	data.ID = types.StringValue(brokerConnection.ID)
 	data.Name = types.StringValue(brokerConnection.Attributes.Name)
 	data.TenantID = types.StringValue(tenantID)
-	data.Type = types.StringValue(string(brokerConnection.Attributes.Configuration.Type))
+	if c := brokerConnection.Attributes.Configuration; c != nil && c.Type != "" {
+		data.Type = types.StringValue(string(c.Type))
+	}
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
@@ -348,6 +534,15 @@ func (r *brokerConnectionResource) Update(ctx context.Context, request resource.
 	if response.Diagnostics.HasError() {
 		return
 	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	var dataConfiguration brokerConnectionResourceConfigurationModel
 	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("configuration"), &dataConfiguration)...)
 	if response.Diagnostics.HasError() {
@@ -359,21 +554,7 @@ func (r *brokerConnectionResource) Update(ctx context.Context, request resource.
 	brokerDeploymentID := data.BrokerDeploymentID.ValueString()
 	brokerConnectionID := data.ID.ValueString()
 
-	updateRequest := &snyk.BrokerConnectionCreateOrUpdateRequest{
-		BitbucketHostname: dataConfiguration.BitbucketHostname.ValueString(),
-		BitbucketPassword: dataConfiguration.BitbucketPasswordCredentialID.ValueString(),
-		BitbucketPAT:      dataConfiguration.BitbucketPATCredentialID.ValueString(),
-		BitbucketUsername: dataConfiguration.BitbucketUsername.ValueString(),
-		BrokerClientURL:   dataConfiguration.BrokerClientURL.ValueString(),
-		GitLabHostname:    dataConfiguration.GitLabHostname.ValueString(),
-		GitLabToken:       dataConfiguration.GitLabTokenCredentialID.ValueString(),
-		JiraHostname:      dataConfiguration.JiraHostname.ValueString(),
-		JiraPassword:      dataConfiguration.JiraPasswordCredentialID.ValueString(),
-		JiraPAT:           dataConfiguration.JiraPATCredentialID.ValueString(),
-		JiraUsername:      dataConfiguration.JiraUsername.ValueString(),
-		Name:              data.Name.ValueString(),
-		Type:              snyk.BrokerConnectionType(data.Type.ValueString()),
-	}
+	updateRequest := newBrokerConnectionCreateOrUpdateRequest(data, dataConfiguration)
 	tflog.Trace(ctx, "Updating broker connection", map[string]any{
 		"app_install_id":       appInstallID,
 		"broker_deployment_id": brokerDeploymentID,
@@ -382,7 +563,7 @@ func (r *brokerConnectionResource) Update(ctx context.Context, request resource.
 	})
 	brokerConnection, resp, err := r.client.Brokers.UpdateConnection(ctx, tenantID, appInstallID, brokerDeploymentID, brokerConnectionID, updateRequest)
 	if err != nil {
-		response.Diagnostics.AddError("Unable to create broker connection", err.Error())
+		response.Diagnostics.AddError("Unable to update broker connection", err.Error())
 		return
 	}
 	tflog.Trace(ctx, "Updated broker connection", map[string]any{
@@ -396,11 +577,17 @@ func (r *brokerConnectionResource) Update(ctx context.Context, request resource.
 	// map response body to model
 	data.AppInstallID = types.StringValue(appInstallID)
 	data.BrokerDeploymentID = types.StringValue(brokerConnection.Attributes.BrokerDeploymentID)
-	// configuration todo
+	configuration, diags := flattenBrokerConnectionConfiguration(ctx, brokerConnection.Attributes.Configuration)
+	response.Diagnostics.Append(diags...)
+	configuration, diags = keepPriorBrokerConnectionConfiguration(ctx, data.Configuration, configuration)
+	response.Diagnostics.Append(diags...)
+	data.Configuration = configuration
 	data.ID = types.StringValue(brokerConnection.ID)
 	data.Name = types.StringValue(brokerConnection.Attributes.Name)
 	data.TenantID = types.StringValue(tenantID)
-	data.Type = types.StringValue(string(brokerConnection.Attributes.Configuration.Type))
+	if c := brokerConnection.Attributes.Configuration; c != nil && c.Type != "" {
+		data.Type = types.StringValue(string(c.Type))
+	}
 
 	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
 }
@@ -414,6 +601,14 @@ func (r *brokerConnectionResource) Delete(ctx context.Context, request resource.
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	tenantID := data.TenantID.ValueString()
 	appInstallID := data.AppInstallID.ValueString()
 	brokerDeploymentID := data.BrokerDeploymentID.ValueString()
@@ -438,3 +633,335 @@ func (r *brokerConnectionResource) Delete(ctx context.Context, request resource.
 		"tenant_id":            tenantID,
 	})
 }
+
+func (r *brokerConnectionResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	idParts := strings.Split(request.ID, ",")
+
+	if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
+		response.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: id,tenant_id,app_install_id,broker_deployment_id. Got: %q", request.ID),
+		)
+		return
+	}
+
+	// "configuration", "name" and "type" are populated by Read
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), idParts[0])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("tenant_id"), idParts[1])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("app_install_id"), idParts[2])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("broker_deployment_id"), idParts[3])...)
+}
+
+// newBrokerConnectionCreateOrUpdateRequest maps the broker connection model and its configuration
+// into a request payload. Attributes which are not set are sent as empty strings and omitted by the SDK.
+func newBrokerConnectionCreateOrUpdateRequest(data brokerConnectionResourceModel, configuration brokerConnectionResourceConfigurationModel) *snyk.BrokerConnectionCreateOrUpdateRequest {
+	return &snyk.BrokerConnectionCreateOrUpdateRequest{
+		ArtifactoryURL:          configuration.ArtifactoryURL.ValueString(),
+		AzureReposHost:          configuration.AzureReposHostname.ValueString(),
+		AzureReposOrg:           configuration.AzureReposOrganization.ValueString(),
+		AzureReposToken:         configuration.AzureReposTokenCredentialID.ValueString(),
+		BitbucketHostname:       configuration.BitbucketHostname.ValueString(),
+		BitbucketPAT:            configuration.BitbucketPATCredentialID.ValueString(),
+		BitbucketPassword:       configuration.BitbucketPasswordCredentialID.ValueString(),
+		BitbucketUsername:       configuration.BitbucketUsername.ValueString(),
+		BrokerClientURL:         configuration.BrokerClientURL.ValueString(),
+		CRAgentURL:              configuration.CRAgentURL.ValueString(),
+		CRBase:                  configuration.CRBase.ValueString(),
+		CRExternalID:            configuration.CRExternalID.ValueString(),
+		CRPassword:              configuration.CRPasswordCredentialID.ValueString(),
+		CRRegion:                configuration.CRRegion.ValueString(),
+		CRRoleARN:               configuration.CRRoleARN.ValueString(),
+		CRToken:                 configuration.CRTokenCredentialID.ValueString(),
+		CRUsername:              configuration.CRUsername.ValueString(),
+		GitHubAPIHostname:       configuration.GitHubAPIHostname.ValueString(),
+		GitHubAppClientID:       configuration.GitHubAppClientID.ValueString(),
+		GitHubAppID:             configuration.GitHubAppID.ValueString(),
+		GitHubAppInstallationID: configuration.GitHubAppInstallationID.ValueString(),
+		GitHubAppPrivatePEMPath: configuration.GitHubAppPrivatePEMPath.ValueString(),
+		GitHubHostname:          configuration.GitHubHostname.ValueString(),
+		GitHubToken:             configuration.GitHubTokenCredentialID.ValueString(),
+		GitLabHostname:          configuration.GitLabHostname.ValueString(),
+		GitLabToken:             configuration.GitLabTokenCredentialID.ValueString(),
+		JiraHostname:            configuration.JiraHostname.ValueString(),
+		JiraPAT:                 configuration.JiraPATCredentialID.ValueString(),
+		JiraPassword:            configuration.JiraPasswordCredentialID.ValueString(),
+		JiraUsername:            configuration.JiraUsername.ValueString(),
+		NexusBaseURL:            configuration.NexusBaseURL.ValueString(),
+		Name:                    data.Name.ValueString(),
+		Type:                    snyk.BrokerConnectionType(data.Type.ValueString()),
+	}
+}
+
+// brokerConnectionConfigurationAttributeTypes returns attribute types of brokerConnectionResourceConfigurationModel.
+func brokerConnectionConfigurationAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"artifactory_url":                  types.StringType,
+		"azure_repos_hostname":             types.StringType,
+		"azure_repos_organization":         types.StringType,
+		"azure_repos_token_credential_id":  types.StringType,
+		"bitbucket_hostname":               types.StringType,
+		"bitbucket_password_credential_id": types.StringType,
+		"bitbucket_pat_credential_id":      types.StringType,
+		"bitbucket_username":               types.StringType,
+		"broker_client_url":                types.StringType,
+		"cr_agent_url":                     types.StringType,
+		"cr_base":                          types.StringType,
+		"cr_external_id":                   types.StringType,
+		"cr_password_credential_id":        types.StringType,
+		"cr_region":                        types.StringType,
+		"cr_role_arn":                      types.StringType,
+		"cr_token_credential_id":           types.StringType,
+		"cr_username":                      types.StringType,
+		"github_api_hostname":              types.StringType,
+		"github_app_client_id":             types.StringType,
+		"github_app_id":                    types.StringType,
+		"github_app_installation_id":       types.StringType,
+		"github_app_private_pem_path":      types.StringType,
+		"github_hostname":                  types.StringType,
+		"github_token_credential_id":       types.StringType,
+		"gitlab_hostname":                  types.StringType,
+		"gitlab_token_credential_id":       types.StringType,
+		"jira_hostname":                    types.StringType,
+		"jira_password_credential_id":      types.StringType,
+		"jira_pat_credential_id":           types.StringType,
+		"jira_username":                    types.StringType,
+		"nexus_base_url":                   types.StringType,
+	}
+}
+
+// flattenBrokerConnectionConfiguration maps the configuration returned by the API into
+// brokerConnectionResourceConfigurationModel. Attributes not supported by the connection
+// type or not returned by the API are set to null.
+func flattenBrokerConnectionConfiguration(ctx context.Context, configuration *snyk.BrokerConnectionAttributesConfiguration) (types.Object, diag.Diagnostics) {
+	model := brokerConnectionResourceConfigurationModel{
+		ArtifactoryURL:                types.StringNull(),
+		AzureReposHostname:            types.StringNull(),
+		AzureReposOrganization:        types.StringNull(),
+		AzureReposTokenCredentialID:   types.StringNull(),
+		BitbucketHostname:             types.StringNull(),
+		BitbucketPasswordCredentialID: types.StringNull(),
+		BitbucketPATCredentialID:      types.StringNull(),
+		BitbucketUsername:             types.StringNull(),
+		BrokerClientURL:               types.StringNull(),
+		CRAgentURL:                    types.StringNull(),
+		CRBase:                        types.StringNull(),
+		CRExternalID:                  types.StringNull(),
+		CRPasswordCredentialID:        types.StringNull(),
+		CRRegion:                      types.StringNull(),
+		CRRoleARN:                     types.StringNull(),
+		CRTokenCredentialID:           types.StringNull(),
+		CRUsername:                    types.StringNull(),
+		GitHubAPIHostname:             types.StringNull(),
+		GitHubAppClientID:             types.StringNull(),
+		GitHubAppID:                   types.StringNull(),
+		GitHubAppInstallationID:       types.StringNull(),
+		GitHubAppPrivatePEMPath:       types.StringNull(),
+		GitHubHostname:                types.StringNull(),
+		GitHubTokenCredentialID:       types.StringNull(),
+		GitLabHostname:                types.StringNull(),
+		GitLabTokenCredentialID:       types.StringNull(),
+		JiraHostname:                  types.StringNull(),
+		JiraPasswordCredentialID:      types.StringNull(),
+		JiraPATCredentialID:           types.StringNull(),
+		JiraUsername:                  types.StringNull(),
+		NexusBaseURL:                  types.StringNull(),
+	}
+
+	if configuration != nil {
+		switch configuration.Type {
+		case snyk.BrokerConnectionTypeACR:
+			if c := configuration.ACR; c != nil {
+				model.BrokerClientURL = stringValueOrNull(c.BrokerClientURL)
+				model.CRAgentURL = stringValueOrNull(c.CRAgentURL)
+				model.CRBase = stringValueOrNull(c.CRBase)
+				model.CRPasswordCredentialID = stringValueOrNull(c.CRPassword)
+				model.CRUsername = stringValueOrNull(c.CRUsername)
+			}
+		case snyk.BrokerConnectionTypeArtifactory:
+			if c := configuration.Artifactory; c != nil {
+				model.ArtifactoryURL = stringValueOrNull(c.ArtifactoryURL)
+			}
+		case snyk.BrokerConnectionTypeArtifactoryCR:
+			if c := configuration.ArtifactoryCR; c != nil {
+				model.BrokerClientURL = stringValueOrNull(c.BrokerClientURL)
+				model.CRAgentURL = stringValueOrNull(c.CRAgentURL)
+				model.CRBase = stringValueOrNull(c.CRBase)
+				model.CRPasswordCredentialID = stringValueOrNull(c.CRPassword)
+				model.CRUsername = stringValueOrNull(c.CRUsername)
+			}
+		case snyk.BrokerConnectionTypeAzureRepos:
+			if c := configuration.AzureRepos; c != nil {
+				model.AzureReposHostname = stringValueOrNull(c.AzureReposHost)
+				model.AzureReposOrganization = stringValueOrNull(c.AzureReposOrg)
+				model.AzureReposTokenCredentialID = stringValueOrNull(c.AzureReposToken)
+				model.BrokerClientURL = stringValueOrNull(c.BrokerClientURL)
+			}
+		case snyk.BrokerConnectionTypeBitbucketServer:
+			if c := configuration.BitbucketServer; c != nil {
+				model.BitbucketHostname = stringValueOrNull(c.BitbucketHostname)
+				model.BitbucketPATCredentialID = stringValueOrNull(c.BitbucketPAT)
+				model.BitbucketPasswordCredentialID = stringValueOrNull(c.BitbucketPassword)
+				model.BitbucketUsername = stringValueOrNull(c.BitbucketUsername)
+				model.BrokerClientURL = stringValueOrNull(c.BrokerClientURL)
+			}
+		case snyk.BrokerConnectionTypeDigitaloceanCR:
+			if c := configuration.DigitaloceanCR; c != nil {
+				model.BrokerClientURL = stringValueOrNull(c.BrokerClientURL)
+				model.CRAgentURL = stringValueOrNull(c.CRAgentURL)
+				model.CRBase = stringValueOrNull(c.CRBase)
+				model.CRTokenCredentialID = stringValueOrNull(c.CRToken)
+			}
+		case snyk.BrokerConnectionTypeDockerHub:
+			if c := configuration.DockerHub; c != nil {
+				model.BrokerClientURL = stringValueOrNull(c.BrokerClientURL)
+				model.CRAgentURL = stringValueOrNull(c.CRAgentURL)
+				model.CRBase = stringValueOrNull(c.CRBase)
+				model.CRPasswordCredentialID = stringValueOrNull(c.CRPassword)
+				model.CRUsername = stringValueOrNull(c.CRUsername)
+			}
+		case snyk.BrokerConnectionTypeECR:
+			if c := configuration.ECR; c != nil {
+				model.BrokerClientURL = stringValueOrNull(c.BrokerClientURL)
+				model.CRAgentURL = stringValueOrNull(c.CRAgentURL)
+				model.CRBase = stringValueOrNull(c.CRBase)
+				model.CRExternalID = stringValueOrNull(c.CRExternalID)
+				model.CRRegion = stringValueOrNull(c.CRRegion)
+				model.CRRoleARN = stringValueOrNull(c.CRRoleARN)
+			}
+		case snyk.BrokerConnectionTypeGCR:
+			if c := configuration.GCR; c != nil {
+				model.BrokerClientURL = stringValueOrNull(c.BrokerClientURL)
+				model.CRAgentURL = stringValueOrNull(c.CRAgentURL)
+				model.CRBase = stringValueOrNull(c.CRBase)
+				model.CRPasswordCredentialID = stringValueOrNull(c.CRPassword)
+				model.CRUsername = stringValueOrNull(c.CRUsername)
+			}
+		case snyk.BrokerConnectionTypeGitHub:
+			if c := configuration.GitHub; c != nil {
+				model.BrokerClientURL = stringValueOrNull(c.BrokerClientURL)
+				model.GitHubTokenCredentialID = stringValueOrNull(c.GitHubToken)
+			}
+		case snyk.BrokerConnectionTypeGitHubCloudApp:
+			if c := configuration.GitHubCloudApp; c != nil {
+				model.BrokerClientURL = stringValueOrNull(c.BrokerClientURL)
+				model.GitHubAPIHostname = stringValueOrNull(c.GitHubAPIHostname)
+				model.GitHubAppClientID = stringValueOrNull(c.GitHubAppClientID)
+				model.GitHubAppID = stringValueOrNull(c.GitHubAppID)
+				model.GitHubAppInstallationID = stringValueOrNull(c.GitHubAppInstallationID)
+				model.GitHubAppPrivatePEMPath = stringValueOrNull(c.GitHubAppPrivatePEMPath)
+				model.GitHubHostname = stringValueOrNull(c.GitHubHostname)
+			}
+		case snyk.BrokerConnectionTypeGitHubCR:
+			if c := configuration.GitHubCR; c != nil {
+				model.BrokerClientURL = stringValueOrNull(c.BrokerClientURL)
+				model.CRAgentURL = stringValueOrNull(c.CRAgentURL)
+				model.CRBase = stringValueOrNull(c.CRBase)
+				model.CRPasswordCredentialID = stringValueOrNull(c.CRPassword)
+				model.CRUsername = stringValueOrNull(c.CRUsername)
+			}
+		case snyk.BrokerConnectionTypeGitHubEnterprise:
+			if c := configuration.GitHubEnterprise; c != nil {
+				model.BrokerClientURL = stringValueOrNull(c.BrokerClientURL)
+				model.GitHubHostname = stringValueOrNull(c.GitHubHostname)
+				model.GitHubTokenCredentialID = stringValueOrNull(c.GitHubToken)
+			}
+		case snyk.BrokerConnectionTypeGitHubServerApp:
+			if c := configuration.GitHubServerApp; c != nil {
+				model.BrokerClientURL = stringValueOrNull(c.BrokerClientURL)
+				model.GitHubAPIHostname = stringValueOrNull(c.GitHubAPIHostname)
+				model.GitHubAppClientID = stringValueOrNull(c.GitHubAppClientID)
+				model.GitHubAppID = stringValueOrNull(c.GitHubAppID)
+				model.GitHubAppInstallationID = stringValueOrNull(c.GitHubAppInstallationID)
+				model.GitHubAppPrivatePEMPath = stringValueOrNull(c.GitHubAppPrivatePEMPath)
+				model.GitHubHostname = stringValueOrNull(c.GitHubHostname)
+			}
+		case snyk.BrokerConnectionTypeGitLab:
+			if c := configuration.GitLab; c != nil {
+				model.BrokerClientURL = stringValueOrNull(c.BrokerClientURL)
+				model.GitLabHostname = stringValueOrNull(c.GitLabHostname)
+				model.GitLabTokenCredentialID = stringValueOrNull(c.GitLabToken)
+			}
+		case snyk.BrokerConnectionTypeGitLabCR:
+			if c := configuration.GitLabCR; c != nil {
+				model.BrokerClientURL = stringValueOrNull(c.BrokerClientURL)
+				model.CRAgentURL = stringValueOrNull(c.CRAgentURL)
+				model.CRBase = stringValueOrNull(c.CRBase)
+				model.CRPasswordCredentialID = stringValueOrNull(c.CRPassword)
+				model.CRUsername = stringValueOrNull(c.CRUsername)
+			}
+		case snyk.BrokerConnectionTypeGoogleArtifactCR:
+			if c := configuration.GoogleArtifactCR; c != nil {
+				model.BrokerClientURL = stringValueOrNull(c.BrokerClientURL)
+				model.CRAgentURL = stringValueOrNull(c.CRAgentURL)
+				model.CRBase = stringValueOrNull(c.CRBase)
+				model.CRPasswordCredentialID = stringValueOrNull(c.CRPassword)
+				model.CRUsername = stringValueOrNull(c.CRUsername)
+			}
+		case snyk.BrokerConnectionTypeHarborCR:
+			if c := configuration.HarborCR; c != nil {
+				model.BrokerClientURL = stringValueOrNull(c.BrokerClientURL)
+				model.CRAgentURL = stringValueOrNull(c.CRAgentURL)
+				model.CRBase = stringValueOrNull(c.CRBase)
+				model.CRPasswordCredentialID = stringValueOrNull(c.CRPassword)
+				model.CRUsername = stringValueOrNull(c.CRUsername)
+			}
+		case snyk.BrokerConnectionTypeJira:
+			if c := configuration.Jira; c != nil {
+				model.JiraHostname = stringValueOrNull(c.JiraHostname)
+				model.JiraPATCredentialID = stringValueOrNull(c.JiraPAT)
+				model.JiraPasswordCredentialID = stringValueOrNull(c.JiraPassword)
+				model.JiraUsername = stringValueOrNull(c.JiraUsername)
+			}
+		case snyk.BrokerConnectionTypeNexus:
+			if c := configuration.Nexus; c != nil {
+				model.NexusBaseURL = stringValueOrNull(c.BaseNexusURL)
+			}
+		case snyk.BrokerConnectionTypeNexusCR:
+			if c := configuration.NexusCR; c != nil {
+				model.BrokerClientURL = stringValueOrNull(c.BrokerClientURL)
+				model.CRAgentURL = stringValueOrNull(c.CRAgentURL)
+				model.CRBase = stringValueOrNull(c.CRBase)
+				model.CRPasswordCredentialID = stringValueOrNull(c.CRPassword)
+				model.CRUsername = stringValueOrNull(c.CRUsername)
+			}
+		case snyk.BrokerConnectionTypeQuayCR:
+			if c := configuration.QuayCR; c != nil {
+				model.BrokerClientURL = stringValueOrNull(c.BrokerClientURL)
+				model.CRAgentURL = stringValueOrNull(c.CRAgentURL)
+				model.CRBase = stringValueOrNull(c.CRBase)
+				model.CRPasswordCredentialID = stringValueOrNull(c.CRPassword)
+				model.CRUsername = stringValueOrNull(c.CRUsername)
+			}
+		}
+	}
+
+	return types.ObjectValueFrom(ctx, brokerConnectionConfigurationAttributeTypes(), model)
+}
+
+// keepPriorBrokerConnectionConfiguration keeps the planned or prior state values of configuration
+// attributes omitted by the API, e.g. attributes not used by the connection type. Otherwise, Terraform
+// reports an inconsistent result after apply or a diff on every plan.
+func keepPriorBrokerConnectionConfiguration(ctx context.Context, prior, configuration types.Object) (types.Object, diag.Diagnostics) {
+	if prior.IsNull() || prior.IsUnknown() || configuration.IsNull() {
+		return configuration, nil
+	}
+
+	priorAttributes := prior.Attributes()
+	attributes := configuration.Attributes()
+	for name, value := range attributes {
+		priorValue, ok := priorAttributes[name]
+		if ok && value.IsNull() && !priorValue.IsUnknown() {
+			attributes[name] = priorValue
+		}
+	}
+
+	return types.ObjectValue(configuration.AttributeTypes(ctx), attributes)
+}
+
+// stringValueOrNull returns null for an empty string, otherwise a known string value.
+func stringValueOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
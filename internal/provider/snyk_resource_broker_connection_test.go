@@ -1,14 +1,25 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func init() {}
@@ -69,6 +80,13 @@ func TestAccSnykBrokerConnectionResource(t *testing.T) {
 					),
 				},
 			},
+			// ImportState testing
+			{
+				ResourceName:      "snyk_broker_connection.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIDFunc("snyk_broker_connection.test", "id", "tenant_id", "app_install_id", "broker_deployment_id"),
+				ImportStateVerify: true,
+			},
 			// Update and Read testing with updated "name"
 			{
 				Config: testAccSnykBrokerConnectionResourceConfig(orgName, groupID, universalBrokerAppID, envVarName, connectionNameUpdated),
@@ -118,6 +136,356 @@ func TestAccSnykBrokerConnectionResource(t *testing.T) {
 	})
 }
 
+func TestBrokerConnectionResource_ReadDetectsConfigurationDrift(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/tenants/tenant-id/brokers/installs/install-id/deployments/deployment-id/connections/connection-id", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		_, _ = fmt.Fprint(w, `
+{
+  "data": {
+    "id": "connection-id",
+    "type": "broker_connection",
+    "attributes": {
+      "deployment_id": "deployment-id",
+      "identifier": "broker-token",
+      "name": "gitlab-connection",
+      "configuration": {
+        "type": "gitlab",
+        "required": {
+          "broker_client_url": "https://api.snyk.io",
+          "gitlab": "gitlab.example.com",
+          "gitlab_token": "credential-id"
+        }
+      }
+    }
+  }
+}`)
+	})
+	ctx := context.Background()
+	r := &brokerConnectionResource{client: newTestClient(t, mux)}
+	state := testBrokerConnectionResourceState(t, r, map[string]string{
+		"broker_client_url":          "https://api.snyk.io",
+		"gitlab_hostname":            "gitlab.com",
+		"gitlab_token_credential_id": "credential-id",
+	})
+
+	response := &fwresource.ReadResponse{State: state}
+	r.Read(ctx, fwresource.ReadRequest{State: state}, response)
+	require.False(t, response.Diagnostics.HasError(), "unexpected diagnostics: %v", response.Diagnostics)
+
+	var configuration brokerConnectionResourceConfigurationModel
+	response.Diagnostics.Append(response.State.GetAttribute(ctx, path.Root("configuration"), &configuration)...)
+	require.False(t, response.Diagnostics.HasError(), "unexpected diagnostics: %v", response.Diagnostics)
+
+	assert.Equal(t, "gitlab.example.com", configuration.GitLabHostname.ValueString(), "expect drifted gitlab hostname in state")
+	assert.Equal(t, "https://api.snyk.io", configuration.BrokerClientURL.ValueString())
+	assert.Equal(t, "credential-id", configuration.GitLabTokenCredentialID.ValueString())
+	assert.True(t, configuration.JiraHostname.IsNull(), "expect attributes of other connection types to be null")
+	assert.False(t, response.State.Raw.Equal(state.Raw), "expect refreshed state to differ from prior state")
+}
+
+func TestBrokerConnectionResource_ReadKeepsPriorConfiguration(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/tenants/tenant-id/brokers/installs/install-id/deployments/deployment-id/connections/connection-id", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = fmt.Fprint(w, `
+{
+  "data": {
+    "id": "connection-id",
+    "type": "broker_connection",
+    "attributes": {
+      "deployment_id": "deployment-id",
+      "identifier": "broker-token",
+      "name": "gitlab-connection",
+      "configuration": {
+        "type": "gitlab",
+        "required": {"broker_client_url": "https://api.snyk.io", "gitlab": "gitlab.com"}
+      }
+    }
+  }
+}`)
+	})
+	ctx := context.Background()
+	r := &brokerConnectionResource{client: newTestClient(t, mux)}
+	state := testBrokerConnectionResourceState(t, r, map[string]string{
+		"broker_client_url":          "https://api.snyk.io",
+		"gitlab_hostname":            "gitlab.com",
+		"gitlab_token_credential_id": "credential-id",
+	})
+
+	response := &fwresource.ReadResponse{State: state}
+	r.Read(ctx, fwresource.ReadRequest{State: state}, response)
+	require.False(t, response.Diagnostics.HasError(), "unexpected diagnostics: %v", response.Diagnostics)
+
+	var configuration brokerConnectionResourceConfigurationModel
+	response.Diagnostics.Append(response.State.GetAttribute(ctx, path.Root("configuration"), &configuration)...)
+	require.False(t, response.Diagnostics.HasError(), "unexpected diagnostics: %v", response.Diagnostics)
+
+	assert.Equal(t, "credential-id", configuration.GitLabTokenCredentialID.ValueString(), "expect prior value, if the API omits it")
+	assert.True(t, response.State.Raw.Equal(state.Raw), "expect refreshed state to equal prior state")
+}
+
+func TestBrokerConnectionResource_CreateKeepsPlannedConfiguration(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/tenants/tenant-id/brokers/installs/install-id/deployments/deployment-id/connections", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = fmt.Fprint(w, `
+{
+  "data": {
+    "id": "connection-id",
+    "type": "broker_connection",
+    "attributes": {
+      "deployment_id": "deployment-id",
+      "identifier": "broker-token",
+      "name": "gitlab-connection",
+      "configuration": {
+        "type": "gitlab",
+        "required": {
+          "broker_client_url": "https://api.snyk.io",
+          "gitlab": "gitlab.com"
+        }
+      }
+    }
+  }
+}`)
+	})
+	ctx := context.Background()
+	r := &brokerConnectionResource{client: newTestClient(t, mux)}
+	state := testBrokerConnectionResourceState(t, r, map[string]string{
+		"broker_client_url":          "https://api.snyk.io",
+		"gitlab_hostname":            "gitlab.com",
+		"gitlab_token_credential_id": "credential-id",
+		"jira_hostname":              "jira.example.com",
+	})
+	plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+	emptyState := tfsdk.State{Schema: state.Schema, Raw: tftypes.NewValue(state.Schema.Type().TerraformType(ctx), nil)}
+
+	response := &fwresource.CreateResponse{State: emptyState}
+	r.Create(ctx, fwresource.CreateRequest{Plan: plan}, response)
+	require.False(t, response.Diagnostics.HasError(), "unexpected diagnostics: %v", response.Diagnostics)
+
+	var configuration brokerConnectionResourceConfigurationModel
+	response.Diagnostics.Append(response.State.GetAttribute(ctx, path.Root("configuration"), &configuration)...)
+	require.False(t, response.Diagnostics.HasError(), "unexpected diagnostics: %v", response.Diagnostics)
+
+	assert.Equal(t, "credential-id", configuration.GitLabTokenCredentialID.ValueString(), "expect planned value of omitted attribute")
+	assert.Equal(t, "jira.example.com", configuration.JiraHostname.ValueString(), "expect planned value of unused attribute")
+	assert.True(t, response.State.Raw.Equal(plan.Raw), "expect state to match plan")
+}
+
+func TestBrokerConnectionResource_ReadRemovesMissingConnection(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/tenants/tenant-id/brokers/installs/install-id/deployments/deployment-id/connections/connection-id", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	ctx := context.Background()
+	r := &brokerConnectionResource{client: newTestClient(t, mux)}
+	state := testBrokerConnectionResourceState(t, r, map[string]string{
+		"broker_client_url":          "https://api.snyk.io",
+		"gitlab_hostname":            "gitlab.com",
+		"gitlab_token_credential_id": "credential-id",
+	})
+
+	response := &fwresource.ReadResponse{State: state}
+	r.Read(ctx, fwresource.ReadRequest{State: state}, response)
+
+	assert.False(t, response.Diagnostics.HasError(), "unexpected diagnostics: %v", response.Diagnostics)
+	assert.True(t, response.State.Raw.IsNull(), "expect resource to be removed from state")
+}
+
+func TestBrokerConnectionResource_ReadWithoutConfiguration(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/tenants/tenant-id/brokers/installs/install-id/deployments/deployment-id/connections/connection-id", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = fmt.Fprint(w, `
+{
+  "data": {
+    "id": "connection-id",
+    "type": "broker_connection",
+    "attributes": {"deployment_id": "deployment-id", "identifier": "broker-token", "name": "gitlab-connection"}
+  }
+}`)
+	})
+	ctx := context.Background()
+	r := &brokerConnectionResource{client: newTestClient(t, mux)}
+	state := testBrokerConnectionResourceState(t, r, map[string]string{
+		"broker_client_url":          "https://api.snyk.io",
+		"gitlab_hostname":            "gitlab.com",
+		"gitlab_token_credential_id": "credential-id",
+	})
+
+	response := &fwresource.ReadResponse{State: state}
+	r.Read(ctx, fwresource.ReadRequest{State: state}, response)
+	require.False(t, response.Diagnostics.HasError(), "unexpected diagnostics: %v", response.Diagnostics)
+
+	var connectionType types.String
+	response.Diagnostics.Append(response.State.GetAttribute(ctx, path.Root("type"), &connectionType)...)
+	require.False(t, response.Diagnostics.HasError(), "unexpected diagnostics: %v", response.Diagnostics)
+	assert.Equal(t, "gitlab", connectionType.ValueString(), "expect prior type, if the API omits the configuration")
+}
+
+func TestBrokerConnectionResource_ImportState(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/tenants/tenant-id/brokers/installs/install-id/deployments/deployment-id/connections/connection-id", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = fmt.Fprint(w, `
+{
+  "data": {
+    "id": "connection-id",
+    "type": "broker_connection",
+    "attributes": {
+      "deployment_id": "deployment-id",
+      "identifier": "broker-token",
+      "name": "github-enterprise-connection",
+      "configuration": {
+        "type": "github-enterprise",
+        "required": {
+          "broker_client_url": "https://api.snyk.io",
+          "github": "github.test",
+          "github_token": "credential-id"
+        }
+      }
+    }
+  }
+}`)
+	})
+	ctx := context.Background()
+	r := &brokerConnectionResource{client: newTestClient(t, mux)}
+	state := testImportStateAndRead(t, r, "connection-id,tenant-id,install-id,deployment-id")
+
+	var data brokerConnectionResourceModel
+	diags := state.Get(ctx, &data)
+	var configuration brokerConnectionResourceConfigurationModel
+	diags.Append(state.GetAttribute(ctx, path.Root("configuration"), &configuration)...)
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+
+	assert.Equal(t, "connection-id", data.ID.ValueString())
+	assert.Equal(t, "tenant-id", data.TenantID.ValueString())
+	assert.Equal(t, "install-id", data.AppInstallID.ValueString())
+	assert.Equal(t, "deployment-id", data.BrokerDeploymentID.ValueString())
+	assert.Equal(t, "github-enterprise-connection", data.Name.ValueString())
+	assert.Equal(t, "github-enterprise", data.Type.ValueString())
+	assert.Equal(t, "https://api.snyk.io", configuration.BrokerClientURL.ValueString())
+	assert.Equal(t, "github.test", configuration.GitHubHostname.ValueString())
+	assert.Equal(t, "credential-id", configuration.GitHubTokenCredentialID.ValueString())
+}
+
+func TestBrokerConnectionResource_ImportStateWithMalformedID(t *testing.T) {
+	t.Parallel()
+
+	testImportStateWithMalformedID(t, &brokerConnectionResource{}, "id,tenant_id,app_install_id,broker_deployment_id", map[string]string{
+		"empty":            "",
+		"missing-parts":    "connection-id,tenant-id,install-id",
+		"empty-part":       "connection-id,,install-id,deployment-id",
+		"additional-parts": "connection-id,tenant-id,install-id,deployment-id,org-id",
+	})
+}
+
+func TestBrokerConnectionResource_ModifyPlanUsesProviderDefaultTenantID(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		unconfigured     bool
+		defaultTenantID  string
+		expectedTenantID types.String
+		expectedError    string
+	}{
+		"provider-default": {
+			defaultTenantID:  "default-tenant-id",
+			expectedTenantID: types.StringValue("default-tenant-id"),
+		},
+		"missing-default": {
+			expectedTenantID: types.StringUnknown(),
+			expectedError:    `The "tenant_id" attribute must be set, or the "default_tenant_id" attribute must be configured in the provider.`,
+		},
+		"unconfigured-provider": {
+			unconfigured:     true,
+			expectedTenantID: types.StringUnknown(),
+			expectedError:    `The "tenant_id" attribute must be set, or the "default_tenant_id" attribute must be configured in the provider.`,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			r := &brokerConnectionResource{defaultTenantID: test.defaultTenantID}
+			if !test.unconfigured {
+				r.client = newTestClient(t, http.NewServeMux())
+			}
+			state := testBrokerConnectionResourceState(t, r, map[string]string{})
+
+			diags := state.SetAttribute(ctx, path.Root("tenant_id"), types.StringNull())
+			require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+			config := tfsdk.Config{Schema: state.Schema, Raw: state.Raw}
+			plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+			diags = plan.SetAttribute(ctx, path.Root("tenant_id"), types.StringUnknown())
+			require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+
+			response := &fwresource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, fwresource.ModifyPlanRequest{Config: config, Plan: plan}, response)
+
+			if test.expectedError != "" {
+				require.True(t, response.Diagnostics.HasError())
+				assert.Equal(t, test.expectedError, response.Diagnostics[0].Detail())
+			} else {
+				require.False(t, response.Diagnostics.HasError(), "unexpected diagnostics: %v", response.Diagnostics)
+			}
+			var tenantID types.String
+			response.Diagnostics.Append(response.Plan.GetAttribute(ctx, path.Root("tenant_id"), &tenantID)...)
+			assert.Equal(t, test.expectedTenantID, tenantID)
+		})
+	}
+}
+
+// testBrokerConnectionResourceState builds a gitlab broker connection state with given configuration attributes.
+func testBrokerConnectionResourceState(t *testing.T, r *brokerConnectionResource, configuration map[string]string) tfsdk.State {
+	t.Helper()
+	ctx := context.Background()
+
+	schemaResponse := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResponse)
+	require.False(t, schemaResponse.Diagnostics.HasError())
+
+	configurationValues := map[string]attr.Value{}
+	for name := range brokerConnectionConfigurationAttributeTypes() {
+		configurationValues[name] = stringValueOrNull(configuration[name])
+	}
+	configurationObject, diags := types.ObjectValue(brokerConnectionConfigurationAttributeTypes(), configurationValues)
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+
+	state := tfsdk.State{
+		Schema: schemaResponse.Schema,
+		Raw:    tftypes.NewValue(schemaResponse.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags = state.Set(ctx, &brokerConnectionResourceModel{
+		AppInstallID:       types.StringValue("install-id"),
+		BrokerDeploymentID: types.StringValue("deployment-id"),
+		Configuration:      configurationObject,
+		ID:                 types.StringValue("connection-id"),
+		Name:               types.StringValue("gitlab-connection"),
+		TenantID:           types.StringValue("tenant-id"),
+		Timeouts: timeouts.Value{Object: types.ObjectNull(map[string]attr.Type{
+			"create": types.StringType,
+			"read":   types.StringType,
+			"update": types.StringType,
+			"delete": types.StringType,
+		})},
+		Type: types.StringValue("gitlab"),
+	})
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+
+	return state
+}
+
 func testAccSnykBrokerConnectionResourceConfig(orgName, groupID, appID, envVarName, connectionName string) string {
 	return fmt.Sprintf(`
 resource "snyk_broker_connection" "test" {
@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -16,26 +17,35 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/pavel-snyk/snyk-sdk-go/v2/snyk"
+
+	"github.com/pavel-snyk/terraform-provider-snyk/internal/provider/helper"
 )
 
 var (
 	_ resource.Resource                = (*brokerDeploymentResource)(nil)
 	_ resource.ResourceWithConfigure   = (*brokerDeploymentResource)(nil)
 	_ resource.ResourceWithImportState = (*brokerDeploymentResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*brokerDeploymentResource)(nil)
 )
 
 // brokerDeploymentResource defines the broker deployment resource implementation.
 type brokerDeploymentResource struct {
-	client *snyk.Client
+	appInstallOrgs *helper.AppInstallOrgResolver
+	client         *snyk.Client
+
+	// provider defaults for unset attributes
+	defaultOrgID    string
+	defaultTenantID string
 }
 
 // brokerDeploymentResourceModel describes the broker deployment resource data model.
 type brokerDeploymentResourceModel struct {
-	AppInstallID types.String `tfsdk:"app_install_id"`
-	ID           types.String `tfsdk:"id"`
-	Metadata     types.Map    `tfsdk:"metadata"`
-	OrgID        types.String `tfsdk:"organization_id"`
-	TenantID     types.String `tfsdk:"tenant_id"`
+	AppInstallID types.String   `tfsdk:"app_install_id"`
+	ID           types.String   `tfsdk:"id"`
+	Metadata     types.Map      `tfsdk:"metadata"`
+	OrgID        types.String   `tfsdk:"organization_id"`
+	TenantID     types.String   `tfsdk:"tenant_id"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
 }
 
 func NewBrokerDeploymentResource() resource.Resource {
@@ -46,7 +56,7 @@ func (r *brokerDeploymentResource) Metadata(_ context.Context, _ resource.Metada
 	response.TypeName = "snyk_broker_deployment"
 }
 
-func (r *brokerDeploymentResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+func (r *brokerDeploymentResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
 	response.Schema = schema.Schema{
 		MarkdownDescription: `
 The broker deployment resource allows to manage Snyk broker deployments.
@@ -81,7 +91,7 @@ For more information, see [Universal Broker documentation](https://docs.snyk.io/
 			"organization_id": schema.StringAttribute{
 				MarkdownDescription: "The ID of the organization where the Universal Broker Snyk App is installed. " +
 					"If omitted, the provider will search for the app installation across all accessible organizations. " +
-					"It's recommended to set for faster performance.",
+					"It's recommended to set for faster performance. Defaults to the `default_organization_id` of the provider.",
 				Optional: true,
 				Computed: true,
 				PlanModifiers: []planmodifier.String{
@@ -90,13 +100,18 @@ For more information, see [Universal Broker documentation](https://docs.snyk.io/
 				},
 			},
 			"tenant_id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the tenant to which the broker deployment belongs.",
-				Required:            true,
+				MarkdownDescription: "The ID of the tenant to which the broker deployment belongs. Defaults to the `default_tenant_id` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
 	}
 }
 
@@ -105,7 +120,7 @@ func (r *brokerDeploymentResource) Configure(_ context.Context, request resource
 		return
 	}
 
-	client, ok := request.ProviderData.(*snyk.Client)
+	providerData, ok := request.ProviderData.(*snykProviderData)
 	if !ok {
 		response.Diagnostics.AddError(
 			"Unconfigured Snyk client",
@@ -114,7 +129,15 @@ func (r *brokerDeploymentResource) Configure(_ context.Context, request resource
 		return
 	}
 
-	r.client = client
+	r.appInstallOrgs = providerData.appInstallOrgs
+	r.client = providerData.client
+	r.defaultOrgID = providerData.defaultOrgID
+	r.defaultTenantID = providerData.defaultTenantID
+}
+
+func (r *brokerDeploymentResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	helper.PlanProviderDefault(ctx, request, response, "organization_id", r.defaultOrgID, "default_organization_id", false)
+	helper.PlanProviderDefault(ctx, request, response, "tenant_id", r.defaultTenantID, "default_tenant_id", true)
 }
 
 func (r *brokerDeploymentResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
@@ -126,6 +149,14 @@ func (r *brokerDeploymentResource) Create(ctx context.Context, request resource.
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultCreateTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	appInstallID := data.AppInstallID.ValueString()
 	orgID := data.OrgID.ValueString()
 	tenantID := data.TenantID.ValueString()
@@ -138,41 +169,13 @@ func (r *brokerDeploymentResource) Create(ctx context.Context, request resource.
 	}
 
 	if orgID == "" {
-		tflog.Info(ctx, "Searching in all accessible orgs for installed app", map[string]any{"app_install_id": appInstallID})
-
-		tflog.Trace(ctx, "Getting all accessible organizations")
-		orgs, errf := r.client.Orgs.AllAccessibleOrgs(ctx, nil)
-		for org := range orgs {
-			tflog.Debug(ctx, "Searching all app installs for org", map[string]any{"org_id": org.ID, "org_name": org.Attributes.Name})
-
-			tflog.Trace(ctx, "Getting app installs for org", map[string]any{"org_id": org.ID})
-			appInstalls, resp, err := r.client.Apps.ListAppInstallsForOrg(ctx, org.ID, nil)
-			if err != nil {
-				response.Diagnostics.AddError("Unable to get app installs", err.Error())
-				return
-			}
-			tflog.Trace(ctx, "Got app installs for org", map[string]any{
-				"data":            appInstalls,
-				"org_id":          org.ID,
-				"snyk_request_id": resp.SnykRequestID,
-			})
-
-			for _, ai := range appInstalls {
-				if ai.ID == appInstallID {
-					tflog.Info(ctx, "Found org for configured app install", map[string]any{
-						"org_id":   org.ID,
-						"org_name": org.Attributes.Name,
-					})
-					orgID = org.ID
-					break
-				}
-			}
-		}
-		if err := errf(); err != nil {
-			response.Diagnostics.AddError("Unable to get organizations", err.Error())
+		tflog.Info(ctx, "Searching in all accessible organizations for app install", map[string]any{"app_install_id": appInstallID})
+		var err error
+		orgID, err = r.appInstallOrgs.ResolveOrgID(ctx, appInstallID)
+		if err != nil {
+			response.Diagnostics.AddError("Unable to find organization", err.Error())
 			return
 		}
-
 		if orgID == "" {
 			response.Diagnostics.AddError(
 				"Unable to find organization",
@@ -225,6 +228,14 @@ func (r *brokerDeploymentResource) Read(ctx context.Context, request resource.Re
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	appInstallID := data.AppInstallID.ValueString()
 	brokerDeploymentID := data.ID.ValueString()
 	orgID := data.OrgID.ValueString()
@@ -266,43 +277,11 @@ func (r *brokerDeploymentResource) Read(ctx context.Context, request resource.Re
 
 	if orgID == "" {
 		tflog.Info(ctx, "Searching in all accessible organizations for app install", map[string]any{"app_install_id": appInstallID})
-
-		tflog.Trace(ctx, "Getting all accessible organizations")
-		orgs, errf := r.client.Orgs.AllAccessibleOrgs(ctx, nil)
-		for org := range orgs {
-			tflog.Debug(ctx, "Searching all app installs for org", map[string]any{
-				"organization_id": org.ID, "organization_name": org.Attributes.Name,
-			})
-
-			tflog.Trace(ctx, "Getting app installs for organization", map[string]any{
-				"organization_id": org.ID, "organization_name": org.Attributes.Name,
-			})
-			appInstalls, resp, err := r.client.Apps.ListAppInstallsForOrg(ctx, org.ID, nil)
-			if err != nil {
-				response.Diagnostics.AddError("Unable to get app installs", err.Error())
-				return
-			}
-			tflog.Trace(ctx, "Got app installs for organization", map[string]any{
-				"data":            appInstalls,
-				"organization_id": org.ID,
-				"snyk_request_id": resp.SnykRequestID,
-			})
-
-			for _, ai := range appInstalls {
-				if ai.ID == appInstallID {
-					tflog.Info(ctx, "Found org for configured app install", map[string]any{
-						"organization_id": org.ID, "organization_name": org.Attributes.Name,
-					})
-					orgID = org.ID
-					break
-				}
-			}
-		}
-		if err := errf(); err != nil {
-			response.Diagnostics.AddError("Unable to get organizations", err.Error())
+		orgID, err = r.appInstallOrgs.ResolveOrgID(ctx, appInstallID)
+		if err != nil {
+			response.Diagnostics.AddError("Unable to find organization", err.Error())
 			return
 		}
-
 		if orgID == "" {
 			response.Diagnostics.AddError(
 				"Unable to find organization",
@@ -337,6 +316,14 @@ func (r *brokerDeploymentResource) Update(ctx context.Context, request resource.
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	appInstallID := data.AppInstallID.ValueString()
 	brokerDeploymentID := data.ID.ValueString()
 	orgID := data.OrgID.ValueString()
@@ -393,6 +380,14 @@ func (r *brokerDeploymentResource) Delete(ctx context.Context, request resource.
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	appInstallID := data.AppInstallID.ValueString()
 	tenantID := data.TenantID.ValueString()
 	brokerDeploymentID := data.ID.ValueString()
@@ -2,8 +2,11 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -11,16 +14,23 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/pavel-snyk/snyk-sdk-go/v2/snyk"
+
+	"github.com/pavel-snyk/terraform-provider-snyk/internal/provider/helper"
 )
 
 var (
-	_ resource.Resource              = (*brokerDeploymentCredentialResource)(nil)
-	_ resource.ResourceWithConfigure = (*brokerDeploymentCredentialResource)(nil)
+	_ resource.Resource                = (*brokerDeploymentCredentialResource)(nil)
+	_ resource.ResourceWithConfigure   = (*brokerDeploymentCredentialResource)(nil)
+	_ resource.ResourceWithImportState = (*brokerDeploymentCredentialResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*brokerDeploymentCredentialResource)(nil)
 )
 
 // brokerDeploymentCredentialResource defines the broker deployment credential resource implementation.
 type brokerDeploymentCredentialResource struct {
 	client *snyk.Client
+
+	// provider defaults for unset attributes
+	defaultTenantID string
 }
 
 // brokerDeploymentCredentialResourceModel describes the broker deployment credential resource data model.
@@ -89,9 +99,11 @@ For more information, see [Universal Broker documentation](https://docs.snyk.io/
 				},
 			},
 			"tenant_id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the tenant to which the broker deployment credential belongs.",
-				Required:            true,
+				MarkdownDescription: "The ID of the tenant to which the broker deployment credential belongs. Defaults to the `default_tenant_id` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
@@ -104,7 +116,7 @@ func (r *brokerDeploymentCredentialResource) Configure(_ context.Context, reques
 		return
 	}
 
-	client, ok := request.ProviderData.(*snyk.Client)
+	providerData, ok := request.ProviderData.(*snykProviderData)
 	if !ok {
 		response.Diagnostics.AddError(
 			"Unconfigured Snyk client",
@@ -113,7 +125,12 @@ func (r *brokerDeploymentCredentialResource) Configure(_ context.Context, reques
 		return
 	}
 
-	r.client = client
+	r.client = providerData.client
+	r.defaultTenantID = providerData.defaultTenantID
+}
+
+func (r *brokerDeploymentCredentialResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	helper.PlanProviderDefault(ctx, request, response, "tenant_id", r.defaultTenantID, "default_tenant_id", true)
 }
 
 func (r *brokerDeploymentCredentialResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
@@ -293,3 +310,21 @@ func (r *brokerDeploymentCredentialResource) Delete(ctx context.Context, request
 		"tenant_id":                       tenantID,
 	})
 }
+
+func (r *brokerDeploymentCredentialResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	idParts := strings.Split(request.ID, ",")
+
+	if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
+		response.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: id,tenant_id,app_install_id,broker_deployment_id. Got: %q", request.ID),
+		)
+		return
+	}
+
+	// "broker_connection_type" and "environment_variable_name" are populated by Read
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), idParts[0])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("tenant_id"), idParts[1])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("app_install_id"), idParts[2])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("broker_deployment_id"), idParts[3])...)
+}
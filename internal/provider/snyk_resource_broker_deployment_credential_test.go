@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"net/http"
 	"strings"
 	"testing"
 
@@ -12,6 +13,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func init() {
@@ -165,6 +168,13 @@ func TestAccSnykBrokerDeploymentCredentialResource(t *testing.T) {
 					),
 				},
 			},
+			// ImportState testing
+			{
+				ResourceName:      "snyk_broker_deployment_credential.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIDFunc("snyk_broker_deployment_credential.test", "id", "tenant_id", "app_install_id", "broker_deployment_id"),
+				ImportStateVerify: true,
+			},
 			// Update and Read testing
 			{
 				Config: testAccSnykBrokerDeploymentCredentialResourceConfig(orgName, groupID, universalBrokerAppID, envVarNameUpdated),
@@ -205,6 +215,51 @@ func TestAccSnykBrokerDeploymentCredentialResource(t *testing.T) {
 	})
 }
 
+func TestBrokerDeploymentCredentialResource_ImportState(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/tenants/tenant-id/brokers/installs/install-id/deployments/deployment-id/credentials/credential-id", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = fmt.Fprint(w, `
+{
+  "data": {
+    "id": "credential-id",
+    "type": "deployment_credential",
+    "attributes": {
+      "deployment_id": "deployment-id",
+      "environment_variable_name": "GITLAB_TOKEN",
+      "type": "gitlab"
+    }
+  }
+}`)
+	})
+	ctx := context.Background()
+	r := &brokerDeploymentCredentialResource{client: newTestClient(t, mux)}
+	state := testImportStateAndRead(t, r, "credential-id,tenant-id,install-id,deployment-id")
+
+	var data brokerDeploymentCredentialResourceModel
+	diags := state.Get(ctx, &data)
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+
+	assert.Equal(t, "credential-id", data.ID.ValueString())
+	assert.Equal(t, "tenant-id", data.TenantID.ValueString())
+	assert.Equal(t, "install-id", data.AppInstallID.ValueString())
+	assert.Equal(t, "deployment-id", data.BrokerDeploymentID.ValueString())
+	assert.Equal(t, "gitlab", data.BrokerConnectionType.ValueString())
+	assert.Equal(t, "GITLAB_TOKEN", data.EnvVarName.ValueString())
+}
+
+func TestBrokerDeploymentCredentialResource_ImportStateWithMalformedID(t *testing.T) {
+	t.Parallel()
+
+	testImportStateWithMalformedID(t, &brokerDeploymentCredentialResource{}, "id,tenant_id,app_install_id,broker_deployment_id", map[string]string{
+		"empty":            "",
+		"missing-parts":    "credential-id,tenant-id,install-id",
+		"empty-part":       "credential-id,tenant-id,,deployment-id",
+		"additional-parts": "credential-id,tenant-id,install-id,deployment-id,connection-id",
+	})
+}
+
 func testAccSnykBrokerDeploymentCredentialResourceConfig(orgName, groupID, appID, envVarName string) string {
 	return fmt.Sprintf(`
 resource "snyk_broker_deployment_credential" "test" {
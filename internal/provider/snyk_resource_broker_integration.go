@@ -2,7 +2,10 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -10,16 +13,24 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/pavel-snyk/snyk-sdk-go/v2/snyk"
+
+	"github.com/pavel-snyk/terraform-provider-snyk/internal/provider/helper"
 )
 
 var (
-	_ resource.Resource              = (*brokerIntegrationResource)(nil)
-	_ resource.ResourceWithConfigure = (*brokerIntegrationResource)(nil)
+	_ resource.Resource                = (*brokerIntegrationResource)(nil)
+	_ resource.ResourceWithConfigure   = (*brokerIntegrationResource)(nil)
+	_ resource.ResourceWithImportState = (*brokerIntegrationResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*brokerIntegrationResource)(nil)
 )
 
 // brokerIntegrationResource defines the broker integration resource implementation.
 type brokerIntegrationResource struct {
 	client *snyk.Client
+
+	// provider defaults for unset attributes
+	defaultOrgID    string
+	defaultTenantID string
 }
 
 // brokerIntegrationResourceModel describes the broker integration resource data model.
@@ -65,16 +76,20 @@ For more information, see [Universal Broker documentation](https://docs.snyk.io/
 				},
 			},
 			"organization_id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the organization to the broker integration is connected to.",
-				Required:            true,
+				MarkdownDescription: "The ID of the organization to the broker integration is connected to. Defaults to the `default_organization_id` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"tenant_id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the tenant to which the broker integration belongs.",
-				Required:            true,
+				MarkdownDescription: "The ID of the tenant to which the broker integration belongs. Defaults to the `default_tenant_id` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
@@ -94,7 +109,7 @@ func (r *brokerIntegrationResource) Configure(_ context.Context, request resourc
 		return
 	}
 
-	client, ok := request.ProviderData.(*snyk.Client)
+	providerData, ok := request.ProviderData.(*snykProviderData)
 	if !ok {
 		response.Diagnostics.AddError(
 			"Unconfigured Snyk client",
@@ -103,7 +118,14 @@ func (r *brokerIntegrationResource) Configure(_ context.Context, request resourc
 		return
 	}
 
-	r.client = client
+	r.client = providerData.client
+	r.defaultOrgID = providerData.defaultOrgID
+	r.defaultTenantID = providerData.defaultTenantID
+}
+
+func (r *brokerIntegrationResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	helper.PlanProviderDefault(ctx, request, response, "organization_id", r.defaultOrgID, "default_organization_id", true)
+	helper.PlanProviderDefault(ctx, request, response, "tenant_id", r.defaultTenantID, "default_tenant_id", true)
 }
 
 func (r *brokerIntegrationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
@@ -241,3 +263,20 @@ func (r *brokerIntegrationResource) Delete(ctx context.Context, request resource
 		"tenant_id":             tenantID,
 	})
 }
+
+func (r *brokerIntegrationResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	idParts := strings.Split(request.ID, ",")
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		response.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: id,tenant_id,broker_connection_id. Got: %q", request.ID),
+		)
+		return
+	}
+
+	// "organization_id" and "type" are populated by Read
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), idParts[0])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("tenant_id"), idParts[1])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("broker_connection_id"), idParts[2])...)
+}
@@ -1,7 +1,9 @@
 package provider
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"regexp"
 	"testing"
 
@@ -10,6 +12,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func init() {}
@@ -55,6 +59,13 @@ func TestAccSnykBrokerIntegrationResource(t *testing.T) {
 					),
 				},
 			},
+			// ImportState testing
+			{
+				ResourceName:      "snyk_broker_integration.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccImportStateIDFunc("snyk_broker_integration.test", "id", "tenant_id", "broker_connection_id"),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -86,6 +97,45 @@ func TestAccSnykBrokerIntegrationResource_expectError(t *testing.T) {
 	})
 }
 
+func TestBrokerIntegrationResource_ImportState(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/rest/tenants/tenant-id/brokers/connections/connection-id/integrations", func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = fmt.Fprint(w, `
+{
+  "data": [
+    {"id": "other-integration-id", "org_id": "other-org-id", "type": "broker_integration", "integration_type": "gitlab"},
+    {"id": "integration-id", "org_id": "org-id", "type": "broker_integration", "integration_type": "gitlab"}
+  ]
+}`)
+	})
+	ctx := context.Background()
+	r := &brokerIntegrationResource{client: newTestClient(t, mux)}
+	state := testImportStateAndRead(t, r, "integration-id,tenant-id,connection-id")
+
+	var data brokerIntegrationResourceModel
+	diags := state.Get(ctx, &data)
+	require.False(t, diags.HasError(), "unexpected diagnostics: %v", diags)
+
+	assert.Equal(t, "integration-id", data.ID.ValueString())
+	assert.Equal(t, "tenant-id", data.TenantID.ValueString())
+	assert.Equal(t, "connection-id", data.BrokerConnectionID.ValueString())
+	assert.Equal(t, "org-id", data.OrgID.ValueString())
+	assert.Equal(t, "gitlab", data.Type.ValueString())
+}
+
+func TestBrokerIntegrationResource_ImportStateWithMalformedID(t *testing.T) {
+	t.Parallel()
+
+	testImportStateWithMalformedID(t, &brokerIntegrationResource{}, "id,tenant_id,broker_connection_id", map[string]string{
+		"empty":            "",
+		"missing-parts":    "integration-id,tenant-id",
+		"empty-part":       "integration-id,,connection-id",
+		"additional-parts": "integration-id,tenant-id,connection-id,org-id",
+	})
+}
+
 func testAccSnykBrokerIntegrationResourceConfig(orgName, groupID, appID, envVarName, connectionName string) string {
 	return fmt.Sprintf(`
 resource "snyk_broker_integration" "test" {
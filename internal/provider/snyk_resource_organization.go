@@ -3,7 +3,9 @@ package provider
 import (
 	"context"
 	"net/http"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -16,24 +18,32 @@ import (
 	"github.com/pavel-snyk/terraform-provider-snyk/internal/provider/helper"
 )
 
+// defaultOrganizationCreateTimeout includes waiting for the tenant of a new organization.
+const defaultOrganizationCreateTimeout = 10 * time.Minute
+
 var (
 	_ resource.Resource                = (*organizationResource)(nil)
 	_ resource.ResourceWithConfigure   = (*organizationResource)(nil)
 	_ resource.ResourceWithImportState = (*organizationResource)(nil)
+	_ resource.ResourceWithModifyPlan  = (*organizationResource)(nil)
 )
 
 // organizationResource defines the organization resource implementation.
 type organizationResource struct {
 	client *snyk.Client
+
+	// provider defaults for unset attributes
+	defaultGroupID string
 }
 
 // organizationResourceModel describes the organization resource data model.
 type organizationResourceModel struct {
-	GroupID  types.String `tfsdk:"group_id"`
-	ID       types.String `tfsdk:"id"`
-	Name     types.String `tfsdk:"name"`
-	Slug     types.String `tfsdk:"slug"`
-	TenantID types.String `tfsdk:"tenant_id"`
+	GroupID  types.String   `tfsdk:"group_id"`
+	ID       types.String   `tfsdk:"id"`
+	Name     types.String   `tfsdk:"name"`
+	Slug     types.String   `tfsdk:"slug"`
+	TenantID types.String   `tfsdk:"tenant_id"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func NewOrganizationResource() resource.Resource {
@@ -44,7 +54,7 @@ func (r *organizationResource) Metadata(_ context.Context, _ resource.MetadataRe
 	response.TypeName = "snyk_organization"
 }
 
-func (r *organizationResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+func (r *organizationResource) Schema(ctx context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
 	response.Schema = schema.Schema{
 		MarkdownDescription: `
 The organization resource allows to manage Snyk organizations.
@@ -54,7 +64,7 @@ have access to these Projects. See [Manage Groups and Organizations](https://doc
 `,
 		Attributes: map[string]schema.Attribute{
 			"group_id": schema.StringAttribute{
-				MarkdownDescription: "The ID of the group to which the organization belongs.",
+				MarkdownDescription: "The ID of the group to which the organization belongs. Defaults to the `default_group_id` of the provider.",
 				Optional:            true,
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
@@ -88,6 +98,9 @@ have access to these Projects. See [Manage Groups and Organizations](https://doc
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Read: true, Update: true, Delete: true}),
+		},
 	}
 }
 
@@ -96,7 +109,7 @@ func (r *organizationResource) Configure(_ context.Context, request resource.Con
 		return
 	}
 
-	client, ok := request.ProviderData.(*snyk.Client)
+	providerData, ok := request.ProviderData.(*snykProviderData)
 	if !ok {
 		response.Diagnostics.AddError(
 			"Unconfigured Snyk client",
@@ -105,7 +118,12 @@ func (r *organizationResource) Configure(_ context.Context, request resource.Con
 		return
 	}
 
-	r.client = client
+	r.client = providerData.client
+	r.defaultGroupID = providerData.defaultGroupID
+}
+
+func (r *organizationResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	helper.PlanProviderDefault(ctx, request, response, "group_id", r.defaultGroupID, "default_group_id", false)
 }
 
 func (r *organizationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
@@ -117,6 +135,14 @@ func (r *organizationResource) Create(ctx context.Context, request resource.Crea
 		return
 	}
 
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultOrganizationCreateTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	createRequest := &snyk.OrganizationV1CreateRequest{
 		Name: data.Name.ValueString(),
 	}
@@ -175,6 +201,14 @@ func (r *organizationResource) Read(ctx context.Context, request resource.ReadRe
 		return
 	}
 
+	readTimeout, diags := data.Timeouts.Read(ctx, defaultReadTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	orgID := data.ID.ValueString()
 	tflog.Trace(ctx, "Getting organization", map[string]any{"org_id": orgID})
 	organization, resp, err := r.client.Orgs.Get(ctx, orgID, nil)
@@ -214,6 +248,14 @@ func (r *organizationResource) Update(ctx context.Context, request resource.Upda
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultUpdateTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
 	orgID := data.ID.ValueString()
 	updateRequest := &snyk.OrganizationUpdateRequest{
 		Name: data.Name.ValueString(),
@@ -250,6 +292,14 @@ func (r *organizationResource) Delete(ctx context.Context, request resource.Dele
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	orgID := data.ID.ValueString()
 	tflog.Trace(ctx, "Deleting organization", map[string]any{"org_id": orgID})
 	resp, err := r.client.OrgsV1.Delete(ctx, orgID)
@@ -4,14 +4,21 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"net/http"
 	"strings"
 	"testing"
+	"time"
 
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func init() {
@@ -131,6 +138,54 @@ func TestAccSnykOrganizationResource(t *testing.T) {
 	})
 }
 
+func TestOrganizationResource_CreateWaitsForTenantWithinCreateTimeout(t *testing.T) {
+	t.Parallel()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/org", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		_, _ = fmt.Fprint(w, `{"id": "org-id", "name": "test-org", "slug": "test-org"}`)
+	})
+	mux.HandleFunc("/rest/orgs/org-id", func(w http.ResponseWriter, _ *http.Request) {
+		// tenant relationship is never populated
+		_, _ = fmt.Fprint(w, `{"data": {"id": "org-id", "type": "org", "attributes": {"name": "test-org", "slug": "test-org"}}}`)
+	})
+	ctx := context.Background()
+	r := &organizationResource{client: newTestClient(t, mux)}
+	schemaResponse := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResponse)
+	require.False(t, schemaResponse.Diagnostics.HasError())
+
+	objectType := schemaResponse.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	timeoutsType := objectType.AttributeTypes["timeouts"].(tftypes.Object)
+	plan := tfsdk.Plan{
+		Schema: schemaResponse.Schema,
+		Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"group_id":  tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"id":        tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"name":      tftypes.NewValue(tftypes.String, "test-org"),
+			"slug":      tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"tenant_id": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			"timeouts": tftypes.NewValue(timeoutsType, map[string]tftypes.Value{
+				"create": tftypes.NewValue(tftypes.String, "100ms"),
+				"read":   tftypes.NewValue(tftypes.String, nil),
+				"update": tftypes.NewValue(tftypes.String, nil),
+				"delete": tftypes.NewValue(tftypes.String, nil),
+			}),
+		}),
+	}
+
+	start := time.Now()
+	response := &fwresource.CreateResponse{
+		State: tfsdk.State{Schema: schemaResponse.Schema, Raw: tftypes.NewValue(objectType, nil)},
+	}
+	r.Create(ctx, fwresource.CreateRequest{Plan: plan}, response)
+
+	require.True(t, response.Diagnostics.HasError())
+	assert.Equal(t, "Unable to wait for organization tenant id to be populated", response.Diagnostics[0].Summary())
+	assert.Less(t, time.Since(start), 3*time.Second, "expect the wait to end with the create timeout")
+}
+
 func testAccSnykOrganizationResourceConfig(name, groupID string) string {
 	return fmt.Sprintf(`
 resource "snyk_organization" "test" {
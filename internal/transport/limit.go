@@ -0,0 +1,67 @@
+package transport
+
+import (
+	"net/http"
+	"sync"
+
+	"golang.org/x/time/rate"
+)
+
+// LimitTransport throttles requests to a maximum rate and a maximum number of concurrent requests.
+// A single LimitTransport is meant to be shared by all requests of a provider, so the limits
+// apply across all resources and data sources.
+type LimitTransport struct {
+	// Base is the underlying transport, http.DefaultTransport if nil.
+	Base http.RoundTripper
+
+	limiter   *rate.Limiter
+	semaphore chan struct{}
+}
+
+// NewLimitTransport returns a transport allowing requestsPerSecond requests per second and
+// maxConcurrentRequests requests in flight. A value of 0 disables the respective limit.
+func NewLimitTransport(base http.RoundTripper, requestsPerSecond float64, maxConcurrentRequests int) *LimitTransport {
+	t := &LimitTransport{Base: base}
+	if requestsPerSecond > 0 {
+		t.limiter = rate.NewLimiter(rate.Limit(requestsPerSecond), 1)
+	}
+	if maxConcurrentRequests > 0 {
+		t.semaphore = make(chan struct{}, maxConcurrentRequests)
+	}
+	return t
+}
+
+func (t *LimitTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	ctx := request.Context()
+
+	release := func() {}
+	if t.semaphore != nil {
+		select {
+		case t.semaphore <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		var once sync.Once
+		release = func() { once.Do(func() { <-t.semaphore }) }
+	}
+
+	if t.limiter != nil {
+		if err := t.limiter.Wait(ctx); err != nil {
+			release()
+			return nil, err
+		}
+	}
+
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	response, err := base.RoundTrip(request)
+	if err != nil {
+		release()
+		return nil, err
+	}
+	// the request is in flight until the caller has read the response body
+	response.Body = &onCloseBody{ReadCloser: response.Body, onClose: release}
+	return response, nil
+}
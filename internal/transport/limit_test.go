@@ -0,0 +1,100 @@
+package transport
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimitTransport_maxConcurrentRequests(t *testing.T) {
+	t.Parallel()
+
+	var inFlight, maxInFlight atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			observed := maxInFlight.Load()
+			if current <= observed || maxInFlight.CompareAndSwap(observed, current) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+	}))
+	t.Cleanup(server.Close)
+	client := &http.Client{Transport: NewLimitTransport(nil, 0, 2)}
+
+	var wg sync.WaitGroup
+	for range 8 {
+		wg.Go(func() {
+			response, err := client.Get(server.URL)
+			if assert.NoError(t, err) {
+				_ = response.Body.Close()
+			}
+		})
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(2), maxInFlight.Load())
+}
+
+func TestLimitTransport_requestsPerSecond(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+	}))
+	t.Cleanup(server.Close)
+	client := &http.Client{Transport: NewLimitTransport(nil, 20, 0)}
+
+	start := time.Now()
+	for range 5 {
+		response, err := client.Get(server.URL)
+		require.NoError(t, err)
+		_ = response.Body.Close()
+	}
+
+	// the first request is allowed immediately, the remaining four wait 50ms each
+	assert.GreaterOrEqual(t, time.Since(start), 190*time.Millisecond)
+	assert.Equal(t, int32(5), calls.Load())
+}
+
+func TestLimitTransport_releasesSlotOnCanceledWait(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	t.Cleanup(server.Close)
+	transport := NewLimitTransport(nil, 0.001, 1)
+	client := &http.Client{Transport: transport}
+
+	// the first request consumes the only rate token
+	response, err := client.Get(server.URL)
+	require.NoError(t, err)
+	_ = response.Body.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	_, err = client.Do(request)
+	require.Error(t, err)
+
+	assert.Empty(t, transport.semaphore, "concurrency slot must be released")
+}
+
+func TestLimitTransport_unlimited(t *testing.T) {
+	t.Parallel()
+
+	transport := NewLimitTransport(nil, 0, 0)
+
+	assert.Nil(t, transport.limiter)
+	assert.Nil(t, transport.semaphore)
+}
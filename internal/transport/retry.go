@@ -0,0 +1,154 @@
+// Package transport provides http.RoundTripper implementations used by the Snyk API client.
+package transport
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	DefaultMaxRetries   = 4
+	DefaultRetryMinWait = 1 * time.Second
+	DefaultRetryMaxWait = 30 * time.Second
+)
+
+// RetryTransport retries requests failed with a rate limit (429), a transient server error
+// (500, 502, 503, 504) or a transport error with exponential backoff. If the response contains
+// a Retry-After header, it is honoured instead of the computed backoff.
+//
+// Server and transport errors are retried for idempotent methods only, because a non-idempotent
+// request (e.g. POST) could have been processed already. Requests with a body, which cannot be
+// replayed because GetBody is not set, are never retried.
+type RetryTransport struct {
+	// Base is the underlying transport, http.DefaultTransport if nil.
+	Base http.RoundTripper
+
+	// MaxRetries is the maximum number of retries after the first attempt, 0 disables retries.
+	MaxRetries int
+
+	// MinWait is the backoff before the first retry, it doubles with every further retry.
+	MinWait time.Duration
+
+	// MaxWait caps the computed backoff.
+	MaxWait time.Duration
+}
+
+func (t *RetryTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	ctx := request.Context()
+	hasBody := request.Body != nil && request.Body != http.NoBody
+	replayable := !hasBody || request.GetBody != nil
+
+	for attempt := 0; ; attempt++ {
+		attemptRequest := request
+		if attempt > 0 && hasBody {
+			body, err := request.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptRequest = request.Clone(ctx)
+			attemptRequest.Body = body
+		}
+
+		response, err := t.roundTrip(attemptRequest)
+		if attempt >= t.MaxRetries || !replayable || !t.shouldRetry(request, response, err) {
+			return response, err
+		}
+
+		wait := t.backoff(attempt, response)
+		fields := map[string]any{
+			"attempt": attempt + 1,
+			"method":  request.Method,
+			"url":     request.URL.String(),
+			"wait":    wait.String(),
+		}
+		if err != nil {
+			fields["error"] = err.Error()
+		} else {
+			fields["status_code"] = response.StatusCode
+
+			// drain the body, so the connection can be reused
+			_, _ = io.Copy(io.Discard, io.LimitReader(response.Body, 4096))
+			_ = response.Body.Close()
+		}
+		tflog.Debug(ctx, "Retrying Snyk API request", fields)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (t *RetryTransport) roundTrip(request *http.Request) (*http.Response, error) {
+	if t.Base == nil {
+		return http.DefaultTransport.RoundTrip(request)
+	}
+	return t.Base.RoundTrip(request)
+}
+
+func (t *RetryTransport) shouldRetry(request *http.Request, response *http.Response, err error) bool {
+	if err != nil {
+		// e.g. a reset connection, but not a canceled request
+		return request.Context().Err() == nil && isIdempotent(request.Method)
+	}
+
+	switch response.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return isIdempotent(request.Method)
+	default:
+		return false
+	}
+}
+
+// backoff returns the wait time before the next retry.
+func (t *RetryTransport) backoff(attempt int, response *http.Response) time.Duration {
+	if response != nil {
+		if retryAfter, ok := parseRetryAfter(response.Header.Get("Retry-After"), time.Now()); ok {
+			return retryAfter
+		}
+	}
+
+	wait := t.MinWait
+	for range attempt {
+		wait *= 2
+		if wait >= t.MaxWait {
+			break
+		}
+	}
+	return min(wait, t.MaxWait)
+}
+
+// parseRetryAfter parses the Retry-After header, which is either delay in seconds or an HTTP date.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(now), 0), true
+	}
+	return 0, false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,309 @@
+package transport
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newFlakyServer returns a server responding with the given status codes in order and 200 afterwards.
+func newFlakyServer(t *testing.T, header http.Header, statusCodes ...int) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := int(calls.Add(1))
+		body, _ := io.ReadAll(r.Body)
+		if call <= len(statusCodes) {
+			for key, values := range header {
+				w.Header()[key] = values
+			}
+			w.WriteHeader(statusCodes[call-1])
+			return
+		}
+		_, _ = w.Write(append([]byte("ok:"), body...))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &calls
+}
+
+// roundTripperFunc adapts a function to http.RoundTripper.
+type roundTripperFunc func(request *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(request *http.Request) (*http.Response, error) {
+	return f(request)
+}
+
+func TestRetryTransport_retriesTooManyRequests(t *testing.T) {
+	t.Parallel()
+
+	server, calls := newFlakyServer(t, nil, http.StatusTooManyRequests, http.StatusTooManyRequests)
+	client := &http.Client{Transport: &RetryTransport{MaxRetries: 3, MinWait: time.Millisecond, MaxWait: 10 * time.Millisecond}}
+
+	request, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader("payload"))
+	require.NoError(t, err)
+	response, err := client.Do(request)
+	require.NoError(t, err)
+	defer func() { _ = response.Body.Close() }()
+	body, err := io.ReadAll(response.Body)
+	require.NoError(t, err)
+
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.Equal(t, "ok:payload", string(body), "request body must be replayed on retry")
+	assert.EqualValues(t, 3, calls.Load())
+}
+
+func TestRetryTransport_honoursRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	server, calls := newFlakyServer(t, http.Header{"Retry-After": []string{"1"}}, http.StatusTooManyRequests)
+	client := &http.Client{Transport: &RetryTransport{MaxRetries: 1, MinWait: time.Millisecond, MaxWait: time.Millisecond}}
+
+	start := time.Now()
+	response, err := client.Get(server.URL)
+	require.NoError(t, err)
+	_ = response.Body.Close()
+
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.EqualValues(t, 2, calls.Load())
+	assert.GreaterOrEqual(t, time.Since(start), time.Second)
+}
+
+func TestRetryTransport_giveUpAfterMaxRetries(t *testing.T) {
+	t.Parallel()
+
+	server, calls := newFlakyServer(t, nil, http.StatusTooManyRequests, http.StatusTooManyRequests, http.StatusTooManyRequests)
+	client := &http.Client{Transport: &RetryTransport{MaxRetries: 1, MinWait: time.Millisecond, MaxWait: time.Millisecond}}
+
+	response, err := client.Get(server.URL)
+	require.NoError(t, err)
+	_ = response.Body.Close()
+
+	assert.Equal(t, http.StatusTooManyRequests, response.StatusCode)
+	assert.EqualValues(t, 2, calls.Load())
+}
+
+func TestRetryTransport_serverErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		method             string
+		statusCode         int
+		expectedStatusCode int
+		expectedCalls      int32
+	}{
+		"get-service-unavailable": {
+			method:             http.MethodGet,
+			statusCode:         http.StatusServiceUnavailable,
+			expectedStatusCode: http.StatusOK,
+			expectedCalls:      2,
+		},
+		"delete-bad-gateway": {
+			method:             http.MethodDelete,
+			statusCode:         http.StatusBadGateway,
+			expectedStatusCode: http.StatusOK,
+			expectedCalls:      2,
+		},
+		"post-service-unavailable": {
+			method:             http.MethodPost,
+			statusCode:         http.StatusServiceUnavailable,
+			expectedStatusCode: http.StatusServiceUnavailable,
+			expectedCalls:      1,
+		},
+		"get-internal-server-error": {
+			method:             http.MethodGet,
+			statusCode:         http.StatusInternalServerError,
+			expectedStatusCode: http.StatusOK,
+			expectedCalls:      2,
+		},
+		"post-internal-server-error": {
+			method:             http.MethodPost,
+			statusCode:         http.StatusInternalServerError,
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedCalls:      1,
+		},
+		"get-not-implemented": {
+			method:             http.MethodGet,
+			statusCode:         http.StatusNotImplemented,
+			expectedStatusCode: http.StatusNotImplemented,
+			expectedCalls:      1,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server, calls := newFlakyServer(t, nil, test.statusCode)
+			client := &http.Client{Transport: &RetryTransport{MaxRetries: 2, MinWait: time.Millisecond, MaxWait: time.Millisecond}}
+
+			request, err := http.NewRequest(test.method, server.URL, nil)
+			require.NoError(t, err)
+			response, err := client.Do(request)
+			require.NoError(t, err)
+			_ = response.Body.Close()
+
+			assert.Equal(t, test.expectedStatusCode, response.StatusCode)
+			assert.Equal(t, test.expectedCalls, calls.Load())
+		})
+	}
+}
+
+func TestRetryTransport_transportErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		method        string
+		expectedError bool
+		expectedCalls int32
+	}{
+		"get": {
+			method:        http.MethodGet,
+			expectedCalls: 2,
+		},
+		"post": {
+			method:        http.MethodPost,
+			expectedError: true,
+			expectedCalls: 1,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls atomic.Int32
+			client := &http.Client{Transport: &RetryTransport{
+				Base: roundTripperFunc(func(request *http.Request) (*http.Response, error) {
+					if calls.Add(1) == 1 {
+						return nil, errors.New("connection reset by peer")
+					}
+					return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: request}, nil
+				}),
+				MaxRetries: 2,
+				MinWait:    time.Millisecond,
+				MaxWait:    time.Millisecond,
+			}}
+
+			request, err := http.NewRequest(test.method, "http://snyk.test", nil)
+			require.NoError(t, err)
+			response, err := client.Do(request)
+
+			if test.expectedError {
+				assert.ErrorContains(t, err, "connection reset by peer")
+			} else {
+				require.NoError(t, err)
+				_ = response.Body.Close()
+				assert.Equal(t, http.StatusOK, response.StatusCode)
+			}
+			assert.Equal(t, test.expectedCalls, calls.Load())
+		})
+	}
+}
+
+func TestRetryTransport_doesNotRetryBodyWithoutGetBody(t *testing.T) {
+	t.Parallel()
+
+	server, calls := newFlakyServer(t, nil, http.StatusTooManyRequests)
+	client := &http.Client{Transport: &RetryTransport{MaxRetries: 2, MinWait: time.Millisecond, MaxWait: time.Millisecond}}
+
+	// a reader which is not a bytes or strings reader, so http.NewRequest doesn't set GetBody
+	request, err := http.NewRequest(http.MethodPost, server.URL, io.MultiReader(strings.NewReader("payload")))
+	require.NoError(t, err)
+	require.Nil(t, request.GetBody)
+	response, err := client.Do(request)
+	require.NoError(t, err)
+	_ = response.Body.Close()
+
+	assert.Equal(t, http.StatusTooManyRequests, response.StatusCode)
+	assert.EqualValues(t, 1, calls.Load())
+}
+
+func TestRetryTransport_stopsOnContextCancellation(t *testing.T) {
+	t.Parallel()
+
+	server, calls := newFlakyServer(t, http.Header{"Retry-After": []string{"60"}}, http.StatusTooManyRequests)
+	client := &http.Client{Transport: &RetryTransport{MaxRetries: 1}}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+	_, err = client.Do(request)
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.EqualValues(t, 1, calls.Load())
+}
+
+func TestRetryTransport_withoutRequestTimeout(t *testing.T) {
+	t.Parallel()
+
+	server, calls := newFlakyServer(t, nil, http.StatusTooManyRequests)
+	client := &http.Client{Transport: &RetryTransport{
+		Base: roundTripperFunc(func(request *http.Request) (*http.Response, error) {
+			_, hasDeadline := request.Context().Deadline()
+			assert.False(t, hasDeadline, "retries must not limit the duration of an attempt")
+			return http.DefaultTransport.RoundTrip(request)
+		}),
+		MaxRetries: 1,
+		MinWait:    time.Millisecond,
+		MaxWait:    time.Millisecond,
+	}}
+
+	response, err := client.Get(server.URL)
+	require.NoError(t, err)
+	_ = response.Body.Close()
+
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.EqualValues(t, 2, calls.Load())
+}
+
+func TestRetryTransport_backoff(t *testing.T) {
+	t.Parallel()
+
+	transport := &RetryTransport{MinWait: time.Second, MaxWait: 5 * time.Second}
+	response := &http.Response{Header: http.Header{}}
+
+	assert.Equal(t, 1*time.Second, transport.backoff(0, response))
+	assert.Equal(t, 2*time.Second, transport.backoff(1, response))
+	assert.Equal(t, 4*time.Second, transport.backoff(2, response))
+	assert.Equal(t, 5*time.Second, transport.backoff(3, response))
+	assert.Equal(t, 5*time.Second, transport.backoff(100, response))
+
+	response.Header.Set("Retry-After", "10")
+	assert.Equal(t, 10*time.Second, transport.backoff(0, response))
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, time.January, 2, 3, 4, 5, 0, time.UTC)
+	tests := map[string]struct {
+		value        string
+		expectedWait time.Duration
+		expectedOK   bool
+	}{
+		"empty":          {value: "", expectedOK: false},
+		"seconds":        {value: "3", expectedWait: 3 * time.Second, expectedOK: true},
+		"negative":       {value: "-1", expectedOK: false},
+		"http-date":      {value: "Thu, 02 Jan 2025 03:04:15 GMT", expectedWait: 10 * time.Second, expectedOK: true},
+		"past-http-date": {value: "Thu, 02 Jan 2025 03:00:00 GMT", expectedWait: 0, expectedOK: true},
+		"invalid":        {value: "soon", expectedOK: false},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			wait, ok := parseRetryAfter(test.value, now)
+
+			assert.Equal(t, test.expectedOK, ok)
+			assert.Equal(t, test.expectedWait, wait)
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package transport
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+// TimeoutTransport limits the time of a single request including reading the response body.
+//
+// Unlike http.Client.Timeout, it is applied to every attempt of a RetryTransport separately
+// and doesn't include the time a request waits in a LimitTransport.
+type TimeoutTransport struct {
+	// Base is the underlying transport, http.DefaultTransport if nil.
+	Base http.RoundTripper
+
+	// Timeout is the request timeout, 0 means no timeout.
+	Timeout time.Duration
+}
+
+func (t *TimeoutTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if t.Timeout <= 0 {
+		return base.RoundTrip(request)
+	}
+
+	ctx, cancel := context.WithTimeout(request.Context(), t.Timeout)
+	response, err := base.RoundTrip(request.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	// the request ends when the caller has read the response body
+	response.Body = &onCloseBody{ReadCloser: response.Body, onClose: cancel}
+	return response, nil
+}
+
+// onCloseBody calls onClose once the response body is closed.
+type onCloseBody struct {
+	io.ReadCloser
+	onClose func()
+}
+
+func (b *onCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.onClose()
+	return err
+}
@@ -0,0 +1,61 @@
+package transport
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeoutTransport(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second):
+			}
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	}))
+	t.Cleanup(server.Close)
+	client := &http.Client{Transport: &TimeoutTransport{Timeout: 50 * time.Millisecond}}
+
+	_, err := client.Get(server.URL + "/slow")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	response, err := client.Get(server.URL + "/fast")
+	require.NoError(t, err)
+	defer func() { _ = response.Body.Close() }()
+	// the response body must still be readable after RoundTrip returned
+	body, err := io.ReadAll(response.Body)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", string(body))
+}
+
+func TestTimeoutTransport_appliesToEveryRetryAttempt(t *testing.T) {
+	t.Parallel()
+
+	server, calls := newFlakyServer(t, nil, http.StatusTooManyRequests, http.StatusTooManyRequests)
+	client := &http.Client{Transport: &RetryTransport{
+		Base:       &TimeoutTransport{Timeout: 100 * time.Millisecond},
+		MaxRetries: 2,
+		MinWait:    60 * time.Millisecond,
+		MaxWait:    60 * time.Millisecond,
+	}}
+
+	// the retries take longer than the timeout in total, but every single attempt is fast
+	response, err := client.Get(server.URL)
+	require.NoError(t, err)
+	_ = response.Body.Close()
+
+	assert.Equal(t, http.StatusOK, response.StatusCode)
+	assert.EqualValues(t, 3, calls.Load())
+}
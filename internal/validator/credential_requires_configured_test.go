@@ -1,175 +1,255 @@
 package validator
 
-//import (
-//	"context"
-//	"testing"
-//
-//	"github.com/hashicorp/terraform-plugin-framework/diag"
-//	"github.com/hashicorp/terraform-plugin-framework/path"
-//	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
-//	"github.com/hashicorp/terraform-plugin-framework/types"
-//	"github.com/hashicorp/terraform-plugin-go/tftypes"
-//	"github.com/stretchr/testify/assert"
-//)
-//
-//func TestRequiredConfiguredCredentialsValidator(t *testing.T) {
-//	t.Parallel()
-//
-//	type testCase struct {
-//		req      tfsdk.ValidateAttributeRequest
-//		expected *tfsdk.ValidateAttributeResponse
-//	}
-//	tests := map[string]testCase{
-//		"non-type_attribute": {
-//			req: tfsdk.ValidateAttributeRequest{
-//				AttributeConfig: types.String{Value: "test-value"},
-//				Config: tfsdk.Config{
-//					Schema: tfsdk.Schema{
-//						Attributes: map[string]tfsdk.Attribute{
-//							"test": {Optional: true, Type: types.StringType},
-//						},
-//					},
-//					Raw: tftypes.NewValue(
-//						tftypes.Object{
-//							AttributeTypes: map[string]tftypes.Type{
-//								"test": tftypes.String,
-//							},
-//						},
-//						map[string]tftypes.Value{
-//							"test": tftypes.NewValue(tftypes.String, "test-value"),
-//						},
-//					),
-//				},
-//			},
-//			expected: &tfsdk.ValidateAttributeResponse{
-//				Diagnostics: diag.Diagnostics{
-//					diag.NewAttributeErrorDiagnostic(
-//						path.Path{},
-//						"Ensure that integration is correctly configured",
-//						"Validator must be applied for integration 'type' only.",
-//					),
-//				},
-//			},
-//		},
-//		"type_attribute": {
-//			req: tfsdk.ValidateAttributeRequest{
-//				AttributeConfig:         types.String{Value: "type-value"},
-//				AttributePath:           path.Root("type"),
-//				AttributePathExpression: path.MatchRoot("type"),
-//				Config: tfsdk.Config{
-//					Schema: tfsdk.Schema{
-//						Attributes: map[string]tfsdk.Attribute{
-//							"type": {Optional: true, Type: types.StringType},
-//						},
-//					},
-//					Raw: tftypes.NewValue(
-//						tftypes.Object{
-//							AttributeTypes: map[string]tftypes.Type{
-//								"type": tftypes.String,
-//							},
-//						},
-//						map[string]tftypes.Value{
-//							"type": tftypes.NewValue(tftypes.String, "type-value"),
-//						},
-//					),
-//				},
-//			},
-//			expected: &tfsdk.ValidateAttributeResponse{},
-//		},
-//	}
-//
-//	for name, test := range tests {
-//		t.Run(name, func(t *testing.T) {
-//			actual := tfsdk.ValidateAttributeResponse{}
-//
-//			RequiresConfiguredCredentials().Validate(context.TODO(), test.req, &actual)
-//
-//			assert.Equal(t, test.expected.Diagnostics, actual.Diagnostics)
-//		})
-//	}
-//}
-//
-//func TestRequiredConfiguredCredentialsValidator_github(t *testing.T) {
-//	t.Parallel()
-//
-//	type testCase struct {
-//		req      tfsdk.ValidateAttributeRequest
-//		expected *tfsdk.ValidateAttributeResponse
-//	}
-//	tests := map[string]testCase{
-//		"with-token": {
-//			req: tfsdk.ValidateAttributeRequest{
-//				AttributeConfig:         types.String{Value: "github"},
-//				AttributePath:           path.Root("type"),
-//				AttributePathExpression: path.MatchRoot("type"),
-//				Config: tfsdk.Config{
-//					Schema: tfsdk.Schema{
-//						Attributes: map[string]tfsdk.Attribute{
-//							"type":  {Optional: true, Type: types.StringType},
-//							"token": {Optional: true, Type: types.StringType},
-//						},
-//					},
-//					Raw: tftypes.NewValue(
-//						tftypes.Object{
-//							AttributeTypes: map[string]tftypes.Type{
-//								"type":  tftypes.String,
-//								"token": tftypes.String,
-//							},
-//						},
-//						map[string]tftypes.Value{
-//							"type":  tftypes.NewValue(tftypes.String, "github"),
-//							"token": tftypes.NewValue(tftypes.String, "github-token"),
-//						},
-//					),
-//				},
-//			},
-//			expected: &tfsdk.ValidateAttributeResponse{},
-//		},
-//		"without-token": {
-//			req: tfsdk.ValidateAttributeRequest{
-//				AttributeConfig:         types.String{Value: "github"},
-//				AttributePath:           path.Root("type"),
-//				AttributePathExpression: path.MatchRoot("type"),
-//				Config: tfsdk.Config{
-//					Schema: tfsdk.Schema{
-//						Attributes: map[string]tfsdk.Attribute{
-//							"type":  {Optional: true, Type: types.StringType},
-//							"token": {Optional: true, Type: types.StringType},
-//						},
-//					},
-//					Raw: tftypes.NewValue(
-//						tftypes.Object{
-//							AttributeTypes: map[string]tftypes.Type{
-//								"type":  tftypes.String,
-//								"token": tftypes.String,
-//							},
-//						},
-//						map[string]tftypes.Value{
-//							"type":  tftypes.NewValue(tftypes.String, "github"),
-//							"token": tftypes.NewValue(tftypes.String, ""),
-//						},
-//					),
-//				},
-//			},
-//			expected: &tfsdk.ValidateAttributeResponse{
-//				Diagnostics: diag.Diagnostics{
-//					diag.NewAttributeErrorDiagnostic(
-//						path.Root("type"),
-//						"Ensure that integration is correctly configured",
-//						"token must be defined and not empty for 'github' integration",
-//					),
-//				},
-//			},
-//		},
-//	}
-//
-//	for name, test := range tests {
-//		t.Run(name, func(t *testing.T) {
-//			actual := tfsdk.ValidateAttributeResponse{}
-//
-//			RequiresConfiguredCredentials().Validate(context.TODO(), test.req, &actual)
-//
-//			assert.Equal(t, test.expected.Diagnostics, actual.Diagnostics)
-//		})
-//	}
-//}
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequiredConfiguredCredentialsValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		req      validator.StringRequest
+		expected *validator.StringResponse
+	}
+	tests := map[string]testCase{
+		"non-type_attribute": {
+			req: validator.StringRequest{
+				ConfigValue:    types.StringValue("test-value"),
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				Config:         testIntegrationConfig(map[string]tftypes.Value{}),
+			},
+			expected: &validator.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("test"),
+						"Ensure that integration is correctly configured",
+						"Validator must be applied for integration 'type' only.",
+					),
+				},
+			},
+		},
+		"type_attribute": {
+			req: validator.StringRequest{
+				ConfigValue:    types.StringValue("type-value"),
+				Path:           path.Root("type"),
+				PathExpression: path.MatchRoot("type"),
+				Config: testIntegrationConfig(map[string]tftypes.Value{
+					"type": tftypes.NewValue(tftypes.String, "type-value"),
+				}),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"unknown_type_attribute": {
+			req: validator.StringRequest{
+				ConfigValue:    types.StringUnknown(),
+				Path:           path.Root("type"),
+				PathExpression: path.MatchRoot("type"),
+				Config: testIntegrationConfig(map[string]tftypes.Value{
+					"type": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				}),
+			},
+			expected: &validator.StringResponse{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual := validator.StringResponse{}
+
+			RequiresConfiguredCredentials().ValidateString(context.TODO(), test.req, &actual)
+
+			assert.Equal(t, test.expected.Diagnostics, actual.Diagnostics)
+		})
+	}
+}
+
+func TestRequiredConfiguredCredentialsValidator_github(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		req      validator.StringRequest
+		expected *validator.StringResponse
+	}
+	tests := map[string]testCase{
+		"with-token": {
+			req: validator.StringRequest{
+				ConfigValue:    types.StringValue("github"),
+				Path:           path.Root("type"),
+				PathExpression: path.MatchRoot("type"),
+				Config: testIntegrationConfig(map[string]tftypes.Value{
+					"type":  tftypes.NewValue(tftypes.String, "github"),
+					"token": tftypes.NewValue(tftypes.String, "github-token"),
+				}),
+			},
+			expected: &validator.StringResponse{},
+		},
+		"without-token": {
+			req: validator.StringRequest{
+				ConfigValue:    types.StringValue("github"),
+				Path:           path.Root("type"),
+				PathExpression: path.MatchRoot("type"),
+				Config: testIntegrationConfig(map[string]tftypes.Value{
+					"type":  tftypes.NewValue(tftypes.String, "github"),
+					"token": tftypes.NewValue(tftypes.String, ""),
+				}),
+			},
+			expected: &validator.StringResponse{
+				Diagnostics: diag.Diagnostics{
+					diag.NewAttributeErrorDiagnostic(
+						path.Root("type"),
+						"Ensure that integration is correctly configured",
+						"token must be defined and not empty for 'github' integration",
+					),
+				},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual := validator.StringResponse{}
+
+			RequiresConfiguredCredentials().ValidateString(context.TODO(), test.req, &actual)
+
+			assert.Equal(t, test.expected.Diagnostics, actual.Diagnostics)
+		})
+	}
+}
+
+func TestRequiredConfiguredCredentialsValidator_integrationTypes(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		integrationType  string
+		credentials      map[string]string
+		expectedMissing  []string
+		unknownAttribute string
+	}
+	tests := map[string]testCase{
+		"acr-without-registry-url": {
+			integrationType: "acr",
+			credentials:     map[string]string{"username": "user", "password": "secret"},
+			expectedMissing: []string{"registry_url"},
+		},
+		"artifactory-cr-without-password": {
+			integrationType: "artifactory-cr",
+			credentials:     map[string]string{"username": "user", "registry_url": "artifactory.example.com"},
+			expectedMissing: []string{"password"},
+		},
+		"bitbucket-cloud": {
+			integrationType: "bitbucket-cloud",
+			credentials:     map[string]string{"username": "user", "password": "secret"},
+		},
+		"bitbucket-connect-app-without-credentials": {
+			integrationType: "bitbucket-connect-app",
+			credentials:     map[string]string{},
+		},
+		"ecr-without-role-arn": {
+			integrationType: "ecr",
+			credentials:     map[string]string{"region": "eu-west-1"},
+			expectedMissing: []string{"role_arn"},
+		},
+		"ecr-without-credentials": {
+			integrationType: "ecr",
+			credentials:     map[string]string{},
+			expectedMissing: []string{"region", "role_arn"},
+		},
+		"github-enterprise-without-url": {
+			integrationType: "github-enterprise",
+			credentials:     map[string]string{"token": "github-token"},
+			expectedMissing: []string{"url"},
+		},
+		"gitlab-with-unknown-token": {
+			integrationType:  "gitlab",
+			credentials:      map[string]string{},
+			unknownAttribute: "token",
+		},
+		"nexus-cr": {
+			integrationType: "nexus-cr",
+			credentials:     map[string]string{"username": "user", "password": "secret", "registry_url": "nexus.example.com"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			values := map[string]tftypes.Value{
+				"type": tftypes.NewValue(tftypes.String, test.integrationType),
+			}
+			for attrName, attrValue := range test.credentials {
+				values[attrName] = tftypes.NewValue(tftypes.String, attrValue)
+			}
+			if test.unknownAttribute != "" {
+				values[test.unknownAttribute] = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+			}
+			request := validator.StringRequest{
+				ConfigValue:    types.StringValue(test.integrationType),
+				Path:           path.Root("type"),
+				PathExpression: path.MatchRoot("type"),
+				Config:         testIntegrationConfig(values),
+			}
+			actual := validator.StringResponse{}
+
+			RequiresConfiguredCredentials().ValidateString(context.TODO(), request, &actual)
+
+			var expected diag.Diagnostics
+			for _, attrName := range test.expectedMissing {
+				expected.AddAttributeError(
+					path.Root("type"),
+					"Ensure that integration is correctly configured",
+					attrName+" must be defined and not empty for '"+test.integrationType+"' integration",
+				)
+			}
+			assert.Equal(t, expected, actual.Diagnostics)
+		})
+	}
+}
+
+func TestAllowedIntegrationTypes_haveRequiredCredentials(t *testing.T) {
+	t.Parallel()
+
+	for _, integrationType := range AllowedIntegrationTypes() {
+		_, ok := requiredCredentials[integrationType]
+		assert.True(t, ok, "integration type %q has no required credentials defined", integrationType)
+	}
+	assert.Len(t, requiredCredentials, len(AllowedIntegrationTypes()))
+}
+
+// testIntegrationConfig builds an integration config with the given values, all other attributes are null.
+func testIntegrationConfig(values map[string]tftypes.Value) tfsdk.Config {
+	attributes := map[string]schema.Attribute{}
+	attributeTypes := map[string]tftypes.Type{}
+	rawValues := map[string]tftypes.Value{}
+	for _, attrName := range []string{
+		"type",
+		attributePassword,
+		attributeRegion,
+		attributeRegistryURL,
+		attributeRoleARN,
+		attributeToken,
+		attributeURL,
+		attributeUsername,
+	} {
+		attributes[attrName] = schema.StringAttribute{Optional: true}
+		attributeTypes[attrName] = tftypes.String
+		rawValues[attrName] = tftypes.NewValue(tftypes.String, nil)
+	}
+	for attrName, value := range values {
+		rawValues[attrName] = value
+	}
+
+	return tfsdk.Config{
+		Schema: schema.Schema{Attributes: attributes},
+		Raw:    tftypes.NewValue(tftypes.Object{AttributeTypes: attributeTypes}, rawValues),
+	}
+}
@@ -1,42 +1,39 @@
 package validator
 
-//import (
-//	"context"
-//
-//	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
-//	"github.com/hashicorp/terraform-plugin-framework/types"
-//)
-//
-//var _ tfsdk.AttributeValidator = notEmptyStringValidator{}
-//
-//// notEmptyStringValidator validates that a string Attribute's content is not empty.
-//type notEmptyStringValidator struct{}
-//
-//func (validator notEmptyStringValidator) Description(_ context.Context) string {
-//	return "string must not be empty"
-//}
-//
-//func (validator notEmptyStringValidator) MarkdownDescription(ctx context.Context) string {
-//	return validator.Description(ctx)
-//}
-//
-//func (validator notEmptyStringValidator) Validate(ctx context.Context, request tfsdk.ValidateAttributeRequest, response *tfsdk.ValidateAttributeResponse) {
-//	s := request.AttributeConfig.(types.String)
-//
-//	if s.Unknown {
-//		return
-//	}
-//
-//	if s.Value == "" {
-//		response.Diagnostics.AddAttributeError(
-//			request.AttributePath,
-//			validator.Description(ctx),
-//			"",
-//		)
-//	}
-//}
-//
-//// NotEmptyString checks that the string is not empty.
-//func NotEmptyString() tfsdk.AttributeValidator {
-//	return &notEmptyStringValidator{}
-//}
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = notEmptyStringValidator{}
+
+// notEmptyStringValidator validates that a string Attribute's content is not empty.
+type notEmptyStringValidator struct{}
+
+func (v notEmptyStringValidator) Description(_ context.Context) string {
+	return "string must not be empty"
+}
+
+func (v notEmptyStringValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v notEmptyStringValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if request.ConfigValue.ValueString() == "" {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			v.Description(ctx),
+			"",
+		)
+	}
+}
+
+// NotEmptyString checks that the string is not empty. Null and unknown values are not validated.
+func NotEmptyString() validator.String {
+	return notEmptyStringValidator{}
+}